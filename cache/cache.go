@@ -0,0 +1,340 @@
+// Package cache provides a generic loading cache with size and time based eviction.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Options configures a LoadingCache. The zero value is an unbounded cache without expiry.
+type Options[K comparable, V any] struct {
+	// MaxSize caps the number of entries; the least recently used entry is evicted first. Zero means unbounded.
+	MaxSize int
+	// TTL is how long a loaded value stays fresh. Zero means values never expire.
+	TTL time.Duration
+	// RefreshAhead reloads a value in the background when a Get hits it within this duration
+	// of its expiry, so callers keep receiving the cached value instead of waiting for a load.
+	RefreshAhead time.Duration
+	// BatchLoader loads several missing keys at once for GetAll. Keys absent from the returned map
+	// are treated as not found. When nil, GetAll loads missing keys one by one.
+	BatchLoader func(ctx context.Context, keys []K) (map[K]V, error)
+	// Now returns the current time; defaults to time.Now.
+	Now func() time.Time
+}
+
+// Stats reports cache activity counters.
+type Stats struct {
+	Hits       uint64
+	Misses     uint64
+	Loads      uint64
+	LoadErrors uint64
+	Refreshes  uint64
+	Evictions  uint64
+}
+
+// LoadingCache caches values produced by a loader function.
+// Concurrent Gets for the same missing key share a single load. It is safe for concurrent use.
+// Invalidating a key while it is loading discards the result of that load instead of caching it.
+type LoadingCache[K comparable, V any] struct {
+	loader   func(ctx context.Context, key K) (V, error)
+	opts     Options[K, V]
+	mu       sync.Mutex
+	items    map[K]*list.Element
+	lru      *list.List
+	inflight map[K]*call[V]
+	loading  map[K]*generation
+	stats    Stats
+}
+
+type entry[K comparable, V any] struct {
+	key        K
+	value      V
+	expiresAt  time.Time
+	refreshing bool
+}
+
+type call[V any] struct {
+	done     chan struct{}
+	value    V
+	err      error
+	panicked any
+}
+
+// generation counts the Invalidate calls for a key while loads of it are running,
+// so a load can tell whether its result is still current.
+type generation struct {
+	current uint64
+	loads   int
+}
+
+// New creates a LoadingCache backed by the loader.
+func New[K comparable, V any](loader func(ctx context.Context, key K) (V, error), opts Options[K, V]) *LoadingCache[K, V] {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	return &LoadingCache[K, V]{
+		loader:   loader,
+		opts:     opts,
+		items:    make(map[K]*list.Element),
+		lru:      list.New(),
+		inflight: make(map[K]*call[V]),
+		loading:  make(map[K]*generation),
+	}
+}
+
+// Get returns the cached value for key, loading it when missing or expired.
+// The load runs in its own goroutine on a context that keeps the values of ctx but not its
+// cancellation, so a caller giving up does not fail the other callers waiting for the same key.
+// If the loader panics, every waiting caller panics with the same value and the next Get retries.
+func (c *LoadingCache[K, V]) Get(ctx context.Context, key K) (V, error) {
+	c.mu.Lock()
+	if value, ok := c.lookup(key); ok {
+		c.stats.Hits++
+		c.mu.Unlock()
+		return value, nil
+	}
+	c.stats.Misses++
+	pending, ok := c.inflight[key]
+	if !ok {
+		pending = &call[V]{done: make(chan struct{})}
+		c.inflight[key] = pending
+		go c.load(context.WithoutCancel(ctx), key, pending, c.beginLoad(key))
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-pending.done:
+		if pending.panicked != nil {
+			panic(pending.panicked)
+		}
+		return pending.value, pending.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// GetAll returns the values for all keys, loading the missing ones through the BatchLoader
+// in a single call when one is configured.
+func (c *LoadingCache[K, V]) GetAll(ctx context.Context, keys []K) (map[K]V, error) {
+	result := make(map[K]V, len(keys))
+	missing := []K{}
+	seen := make(map[K]bool, len(keys))
+
+	c.mu.Lock()
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if value, ok := c.lookup(key); ok {
+			c.stats.Hits++
+			result[key] = value
+			continue
+		}
+		c.stats.Misses++
+		missing = append(missing, key)
+	}
+	c.mu.Unlock()
+
+	if len(missing) == 0 {
+		return result, nil
+	}
+	if c.opts.BatchLoader == nil {
+		for _, key := range missing {
+			value, err := c.Get(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = value
+		}
+		return result, nil
+	}
+
+	c.mu.Lock()
+	generations := make(map[K]uint64, len(missing))
+	for _, key := range missing {
+		generations[key] = c.beginLoad(key)
+	}
+	c.mu.Unlock()
+
+	var loaded map[K]V
+	defer func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for key, gen := range generations {
+			value, ok := loaded[key]
+			if c.endLoad(key, gen) && ok {
+				c.store(key, value)
+			}
+		}
+	}()
+
+	batch, err := c.opts.BatchLoader(ctx, missing)
+	c.mu.Lock()
+	c.stats.Loads++
+	if err != nil {
+		c.stats.LoadErrors++
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("cache: batch load %d keys: %w", len(missing), err)
+	}
+	loaded = batch
+	for _, key := range missing {
+		if value, ok := loaded[key]; ok {
+			result[key] = value
+		}
+	}
+	return result, nil
+}
+
+// Invalidate removes key from the cache.
+func (c *LoadingCache[K, V]) Invalidate(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.items[key]; ok {
+		c.lru.Remove(element)
+		delete(c.items, key)
+	}
+	delete(c.inflight, key)
+	if gen, ok := c.loading[key]; ok {
+		gen.current++
+	}
+}
+
+// Len returns the number of cached entries, including expired ones not yet evicted.
+func (c *LoadingCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *LoadingCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// lookup returns a fresh cached value and schedules a refresh-ahead when due. c.mu must be held.
+func (c *LoadingCache[K, V]) lookup(key K) (V, bool) {
+	var zero V
+	element, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+	cached := element.Value.(*entry[K, V])
+	now := c.opts.Now()
+	if c.opts.TTL > 0 && !now.Before(cached.expiresAt) {
+		c.lru.Remove(element)
+		delete(c.items, key)
+		return zero, false
+	}
+	c.lru.MoveToFront(element)
+	if c.opts.TTL > 0 && c.opts.RefreshAhead > 0 && !cached.refreshing && cached.expiresAt.Sub(now) <= c.opts.RefreshAhead {
+		cached.refreshing = true
+		go c.refresh(key, c.beginLoad(key))
+	}
+	return cached.value, true
+}
+
+// load runs the loader for the callers waiting on pending. The deferred cleanup releases
+// them even when the loader panics, so the key is never left loading.
+func (c *LoadingCache[K, V]) load(ctx context.Context, key K, pending *call[V], gen uint64) {
+	defer func() {
+		pending.panicked = recover()
+		c.mu.Lock()
+		if c.inflight[key] == pending {
+			delete(c.inflight, key)
+		}
+		c.stats.Loads++
+		failed := pending.err != nil || pending.panicked != nil
+		if failed {
+			c.stats.LoadErrors++
+		}
+		if c.endLoad(key, gen) && !failed {
+			c.store(key, pending.value)
+		}
+		c.mu.Unlock()
+		close(pending.done)
+	}()
+
+	pending.value, pending.err = c.loader(ctx, key)
+	if pending.err != nil {
+		pending.err = fmt.Errorf("cache: load key %v: %w", key, pending.err)
+	}
+}
+
+// refresh reloads key in the background, keeping the old value when the load fails or panics.
+func (c *LoadingCache[K, V]) refresh(key K, gen uint64) {
+	var (
+		value V
+		err   error
+	)
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("cache: refresh key %v panicked: %v", key, recovered)
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.stats.Refreshes++
+		if err != nil {
+			c.stats.LoadErrors++
+		}
+		if !c.endLoad(key, gen) || err != nil {
+			if element, ok := c.items[key]; ok {
+				element.Value.(*entry[K, V]).refreshing = false
+			}
+			return
+		}
+		c.store(key, value)
+	}()
+
+	value, err = c.loader(context.Background(), key)
+}
+
+// beginLoad registers a load of key and returns its generation. c.mu must be held.
+func (c *LoadingCache[K, V]) beginLoad(key K) uint64 {
+	gen, ok := c.loading[key]
+	if !ok {
+		gen = &generation{}
+		c.loading[key] = gen
+	}
+	gen.loads++
+	return gen.current
+}
+
+// endLoad unregisters a load of key and reports whether key was not invalidated since it began.
+// c.mu must be held.
+func (c *LoadingCache[K, V]) endLoad(key K, started uint64) bool {
+	gen := c.loading[key]
+	gen.loads--
+	if gen.loads == 0 {
+		delete(c.loading, key)
+	}
+	return gen.current == started
+}
+
+// store inserts or replaces key and evicts the least recently used entries. c.mu must be held.
+func (c *LoadingCache[K, V]) store(key K, value V) {
+	var expiresAt time.Time
+	if c.opts.TTL > 0 {
+		expiresAt = c.opts.Now().Add(c.opts.TTL)
+	}
+	if element, ok := c.items[key]; ok {
+		element.Value = &entry[K, V]{key: key, value: value, expiresAt: expiresAt}
+		c.lru.MoveToFront(element)
+		return
+	}
+	c.items[key] = c.lru.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	for c.opts.MaxSize > 0 && c.lru.Len() > c.opts.MaxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry[K, V]).key)
+		c.stats.Evictions++
+	}
+}
@@ -0,0 +1,309 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+func countingLoader(calls *int32) func(ctx context.Context, key int) (string, error) {
+	return func(ctx context.Context, key int) (string, error) {
+		n := atomic.AddInt32(calls, 1)
+		return strconv.Itoa(key) + "#" + strconv.Itoa(int(n)), nil
+	}
+}
+
+func TestLoadingCacheGet(t *testing.T) {
+	t.Run("loads once and then hits", func(t *testing.T) {
+		var calls int32
+		c := New(countingLoader(&calls), Options[int, string]{})
+
+		first, err := c.Get(context.Background(), 1)
+		assert.NoError(t, err)
+		second, _ := c.Get(context.Background(), 1)
+
+		assert.Equal(t, "1#1", first)
+		assert.Equal(t, first, second)
+		assert.Equal(t, Stats{Hits: 1, Misses: 1, Loads: 1}, c.Stats())
+	})
+
+	t.Run("load errors are not cached", func(t *testing.T) {
+		fail := true
+		c := New(func(ctx context.Context, key string) (int, error) {
+			if fail {
+				return 0, errors.New("down")
+			}
+			return len(key), nil
+		}, Options[string, int]{})
+
+		_, err := c.Get(context.Background(), "abc")
+		assert.EqualError(t, err, "cache: load key abc: down")
+
+		fail = false
+		value, err := c.Get(context.Background(), "abc")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, value)
+		assert.Equal(t, uint64(1), c.Stats().LoadErrors)
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		var calls int32
+		c := New(countingLoader(&calls), Options[int, string]{MaxSize: 2})
+		ctx := context.Background()
+
+		_, _ = c.Get(ctx, 1)
+		_, _ = c.Get(ctx, 2)
+		_, _ = c.Get(ctx, 1)
+		_, _ = c.Get(ctx, 3)
+
+		assert.Equal(t, 2, c.Len())
+		assert.Equal(t, uint64(1), c.Stats().Evictions)
+		value, _ := c.Get(ctx, 2)
+		assert.Equal(t, "2#4", value)
+	})
+
+	t.Run("expires after TTL", func(t *testing.T) {
+		var calls int32
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		c := New(countingLoader(&calls), Options[int, string]{TTL: time.Minute, Now: clock.Now})
+		ctx := context.Background()
+
+		first, _ := c.Get(ctx, 1)
+		clock.Advance(59 * time.Second)
+		cached, _ := c.Get(ctx, 1)
+		clock.Advance(time.Second)
+		reloaded, _ := c.Get(ctx, 1)
+
+		assert.Equal(t, "1#1", first)
+		assert.Equal(t, "1#1", cached)
+		assert.Equal(t, "1#2", reloaded)
+	})
+
+	t.Run("refreshes ahead of expiry", func(t *testing.T) {
+		var calls int32
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		c := New(countingLoader(&calls), Options[int, string]{TTL: time.Minute, RefreshAhead: 10 * time.Second, Now: clock.Now})
+		ctx := context.Background()
+
+		_, _ = c.Get(ctx, 1)
+		clock.Advance(55 * time.Second)
+		stale, _ := c.Get(ctx, 1)
+		assert.Equal(t, "1#1", stale)
+
+		assert.Eventually(t, func() bool { return c.Stats().Refreshes == 1 }, time.Second, time.Millisecond)
+		fresh, _ := c.Get(ctx, 1)
+		assert.Equal(t, "1#2", fresh)
+	})
+
+	t.Run("concurrent gets share one load", func(t *testing.T) {
+		var calls int32
+		release := make(chan struct{})
+		c := New(func(ctx context.Context, key int) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			<-release
+			return key * 2, nil
+		}, Options[int, int]{})
+
+		var wg sync.WaitGroup
+		results := make([]int, 10)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = c.Get(context.Background(), 21)
+			}(i)
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		for _, result := range results {
+			assert.Equal(t, 42, result)
+		}
+	})
+
+	t.Run("a panicking load does not block later gets", func(t *testing.T) {
+		var calls int32
+		c := New(func(ctx context.Context, key int) (int, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				panic("boom")
+			}
+			return key * 2, nil
+		}, Options[int, int]{})
+
+		assert.PanicsWithValue(t, "boom", func() { _, _ = c.Get(context.Background(), 21) })
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		value, err := c.Get(ctx, 21)
+		assert.NoError(t, err)
+		assert.Equal(t, 42, value)
+	})
+
+	t.Run("a cancelled caller does not fail the other waiters", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		c := New(func(ctx context.Context, key int) (int, error) {
+			close(started)
+			select {
+			case <-release:
+				return key * 2, nil
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}, Options[int, int]{})
+
+		first, cancelFirst := context.WithCancel(context.Background())
+		firstErr := make(chan error, 1)
+		go func() {
+			_, err := c.Get(first, 21)
+			firstErr <- err
+		}()
+		<-started
+
+		second := make(chan int, 1)
+		go func() {
+			value, _ := c.Get(context.Background(), 21)
+			second <- value
+		}()
+		cancelFirst()
+		assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+		close(release)
+		assert.Equal(t, 42, <-second)
+	})
+}
+
+func TestLoadingCacheGetAll(t *testing.T) {
+	t.Run("batch loads only missing keys", func(t *testing.T) {
+		batches := [][]int{}
+		c := New(func(ctx context.Context, key int) (int, error) {
+			return 0, errors.New("single loads are not expected")
+		}, Options[int, int]{BatchLoader: func(ctx context.Context, keys []int) (map[int]int, error) {
+			batches = append(batches, keys)
+			result := map[int]int{}
+			for _, key := range keys {
+				if key != 4 {
+					result[key] = key * 10
+				}
+			}
+			return result, nil
+		}})
+		ctx := context.Background()
+
+		first, err := c.GetAll(ctx, []int{1, 2, 2})
+		assert.NoError(t, err)
+		second, err := c.GetAll(ctx, []int{2, 3, 4})
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[int]int{1: 10, 2: 20}, first)
+		assert.Equal(t, map[int]int{2: 20, 3: 30}, second)
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}}, batches)
+	})
+
+	t.Run("falls back to the loader", func(t *testing.T) {
+		var calls int32
+		c := New(countingLoader(&calls), Options[int, string]{})
+
+		result, err := c.GetAll(context.Background(), []int{1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]string{1: "1#1", 2: "2#2"}, result)
+	})
+
+	t.Run("batch error", func(t *testing.T) {
+		c := New(func(ctx context.Context, key int) (int, error) { return key, nil }, Options[int, int]{
+			BatchLoader: func(ctx context.Context, keys []int) (map[int]int, error) { return nil, errors.New("down") },
+		})
+
+		_, err := c.GetAll(context.Background(), []int{1})
+		assert.EqualError(t, err, "cache: batch load 1 keys: down")
+	})
+}
+
+func TestLoadingCacheInvalidate(t *testing.T) {
+	var calls int32
+	c := New(countingLoader(&calls), Options[int, string]{})
+
+	_, _ = c.Get(context.Background(), 1)
+	c.Invalidate(1)
+	value, _ := c.Get(context.Background(), 1)
+
+	assert.Equal(t, "1#2", value)
+}
+
+func TestLoadingCacheInvalidateWhileLoading(t *testing.T) {
+	t.Run("drops the result of an in-flight get", func(t *testing.T) {
+		var calls int32
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+		c := New(func(ctx context.Context, key int) (string, error) {
+			n := atomic.AddInt32(&calls, 1)
+			started <- struct{}{}
+			if n == 1 {
+				<-release
+			}
+			return strconv.Itoa(key) + "#" + strconv.Itoa(int(n)), nil
+		}, Options[int, string]{})
+
+		stale := make(chan string, 1)
+		go func() {
+			value, _ := c.Get(context.Background(), 1)
+			stale <- value
+		}()
+		<-started
+		c.Invalidate(1)
+		close(release)
+
+		assert.Equal(t, "1#1", <-stale)
+		value, _ := c.Get(context.Background(), 1)
+		assert.Equal(t, "1#2", value)
+	})
+
+	t.Run("drops the result of a refresh", func(t *testing.T) {
+		var calls int32
+		release := make(chan struct{})
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		c := New(func(ctx context.Context, key int) (string, error) {
+			n := atomic.AddInt32(&calls, 1)
+			if n == 2 {
+				<-release
+			}
+			return strconv.Itoa(key) + "#" + strconv.Itoa(int(n)), nil
+		}, Options[int, string]{TTL: time.Minute, RefreshAhead: 10 * time.Second, Now: clock.Now})
+		ctx := context.Background()
+
+		_, _ = c.Get(ctx, 1)
+		clock.Advance(55 * time.Second)
+		_, _ = c.Get(ctx, 1)
+		c.Invalidate(1)
+		close(release)
+
+		assert.Eventually(t, func() bool { return c.Stats().Refreshes == 1 }, time.Second, time.Millisecond)
+		assert.Equal(t, 0, c.Len())
+	})
+}
@@ -1,9 +1,11 @@
 package collection
 
 import (
+	stderrors "errors"
 	"fmt"
 	"sort"
 
+	"github.com/lumiluminousai/golang-fp-utility/conditional"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/constraints"
 )
@@ -54,15 +56,50 @@ func FlatMap[T1 any](source [][]T1) []T1 {
 	return result
 }
 
+// FlatMapFunc applies a transformation function returning a list to each item and
+// concatenates the results into a single list.
+// Example:
+//   - FlatMapFunc(orders, func(o Order) []LineItem { return o.Lines }) returns every line item.
+func FlatMapFunc[T1 any, T2 any](source []T1, transform func(item T1) []T2) []T2 {
+	result := []T2{}
+	for _, item := range source {
+		result = append(result, transform(item)...)
+	}
+	return result
+}
+
 // Reduce reduces a list to a single value using the provided function.
+// Use Fold when the accumulator has a different type than the elements.
 func Reduce[T any](source []T, reduceFunc func(acc T, item T) T, initialValue T) T {
+	return Fold(source, reduceFunc, initialValue)
+}
+
+// Fold reduces a list to an accumulator of any type using the provided function.
+// Example:
+//   - Fold(orders, func(s summary, o Order) summary { s.Total += o.Amount; s.Count++; return s }, summary{})
+func Fold[T any, A any](source []T, foldFunc func(acc A, item T) A, initialValue A) A {
 	acc := initialValue
 	for _, item := range source {
-		acc = reduceFunc(acc, item)
+		acc = foldFunc(acc, item)
 	}
 	return acc
 }
 
+// ReduceWithError reduces a list to a single value using a function that may fail.
+// It stops at the first error and returns it wrapped with the failing index.
+func ReduceWithError[T any, A any](source []T, reduceFunc func(acc A, item T) (A, error), initialValue A) (A, error) {
+	acc := initialValue
+	for idx, item := range source {
+		next, err := reduceFunc(acc, item)
+		if err != nil {
+			var zero A
+			return zero, errors.Wrap(err, fmt.Sprintf("error reducing at index:'%v', error", idx))
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
 // Summable includes all types that can be summed, such as integers and floats.
 type Summable interface {
 	int | int32 | int64 | float32 | float64
@@ -112,13 +149,60 @@ func Distinct[T comparable](slice []T) []T {
 	return unique
 }
 
-// DistinctFunc returns a slice containing unique elements using a custom comparison function.
-func DistinctFunc[T comparable](slice []T, compareFunc func(a, b T) bool) []T {
-	seen := make(map[T]bool)
+// DistinctFunc returns a slice containing the first occurrence of each element, where two elements
+// are duplicates when equal reports true. It compares each element with every element kept so far,
+// so it runs in O(n²); use DistinctFuncHashed when a hash consistent with equal is available.
+func DistinctFunc[T any](slice []T, equal func(a, b T) bool) []T {
 	unique := []T{}
 	for _, item := range slice {
-		if !seen[item] {
-			seen[item] = true
+		duplicate := false
+		for _, kept := range unique {
+			if equal(kept, item) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			unique = append(unique, item)
+		}
+	}
+	return unique
+}
+
+// DistinctFuncHashed is the fast path of DistinctFunc: elements are only compared with equal
+// when hash returns the same key for them, so it runs in O(n) for well-spread hashes.
+// hash must be consistent with equal: equal(a, b) must imply hash(a) == hash(b).
+// Example:
+//   - DistinctFuncHashed(names, strings.EqualFold, strings.ToLower)
+func DistinctFuncHashed[T any, K comparable](slice []T, equal func(a, b T) bool, hash func(item T) K) []T {
+	buckets := make(map[K][]T)
+	unique := []T{}
+	for _, item := range slice {
+		key := hash(item)
+		duplicate := false
+		for _, kept := range buckets[key] {
+			if equal(kept, item) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			buckets[key] = append(buckets[key], item)
+			unique = append(unique, item)
+		}
+	}
+	return unique
+}
+
+// DistinctBy returns a slice containing the first element for each key returned by keyFunc.
+// It is the hash-based counterpart of DistinctFunc and runs in O(n).
+func DistinctBy[T any, K comparable](slice []T, keyFunc func(item T) K) []T {
+	seen := make(map[K]bool)
+	unique := []T{}
+	for _, item := range slice {
+		key := keyFunc(item)
+		if !seen[key] {
+			seen[key] = true
 			unique = append(unique, item)
 		}
 	}
@@ -142,6 +226,34 @@ func ForEachWithError[T any](source []T, action func(item T) error) error {
 	return nil
 }
 
+// ForEachOptions configures ForEachWithErrorOptions.
+type ForEachOptions struct {
+	// ContinueOnError runs the action for every item and joins all failures
+	// instead of stopping at the first one.
+	ContinueOnError bool
+}
+
+// ForEachWithErrorOptions executes a function for each item, handling errors according to opts.
+func ForEachWithErrorOptions[T any](source []T, action func(item T) error, opts ForEachOptions) error {
+	if !opts.ContinueOnError {
+		return ForEachWithError(source, action)
+	}
+
+	errs := []error{}
+	for idx, item := range source {
+		if err := action(item); err != nil {
+			errs = append(errs, errors.Wrap(err, fmt.Sprintf("error at index:'%v', error", idx)))
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+// ForEachCollectErrors executes a function for every item and returns a joined error
+// listing each failing index, instead of stopping at the first failure.
+func ForEachCollectErrors[T any](source []T, action func(item T) error) error {
+	return ForEachWithErrorOptions(source, action, ForEachOptions{ContinueOnError: true})
+}
+
 // MapReturnWithError applies a transformation function to each item and handles errors.
 func MapReturnWithError[T1 any, T2 any](source []T1, mappingFunc func(item T1) (T2, error)) ([]T2, error) {
 	result := []T2{}
@@ -156,6 +268,55 @@ func MapReturnWithError[T1 any, T2 any](source []T1, mappingFunc func(item T1) (
 	return result, nil
 }
 
+// MapOptions configures MapReturnWithErrorOptions.
+type MapOptions struct {
+	// ContinueOnError maps every item and joins all failures instead of stopping
+	// at the first one. The successful results are still returned.
+	ContinueOnError bool
+}
+
+// MapReturnWithErrorOptions applies a transformation function to each item, handling errors according to opts.
+func MapReturnWithErrorOptions[T1 any, T2 any](source []T1, mappingFunc func(item T1) (T2, error), opts MapOptions) ([]T2, error) {
+	if !opts.ContinueOnError {
+		return MapReturnWithError(source, mappingFunc)
+	}
+
+	result := []T2{}
+	errs := []error{}
+
+	for idx, item := range source {
+		res, err := mappingFunc(item)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, fmt.Sprintf("error mapping at index:'%v', error", idx)))
+			continue
+		}
+		result = append(result, res)
+	}
+	return result, stderrors.Join(errs...)
+}
+
+// MapCollectErrors applies a transformation function to every item, even after a failure.
+// It returns the results of the successful items in their original order together with
+// an errors.Join aggregate of every failure, each wrapped with its index.
+func MapCollectErrors[T1 any, T2 any](source []T1, mappingFunc func(item T1) (T2, error)) ([]T2, error) {
+	return MapReturnWithErrorOptions(source, mappingFunc, MapOptions{ContinueOnError: true})
+}
+
+// MapIf applies fn to the elements satisfying the predicate and keeps the others unchanged.
+// Example:
+//   - MapIf([]int{1, -2, 3}, isNegative, negate) returns [1 2 3].
+func MapIf[T any](source []T, predicate func(item T) bool, fn func(item T) T) []T {
+	result := make([]T, len(source))
+	for i, item := range source {
+		if predicate(item) {
+			result[i] = fn(item)
+		} else {
+			result[i] = item
+		}
+	}
+	return result
+}
+
 // Filter returns a filtered list based on the provided function.
 func Filter[T any](source []T, filterFunc func(item T) bool) []T {
 	result := []T{}
@@ -167,6 +328,23 @@ func Filter[T any](source []T, filterFunc func(item T) bool) []T {
 	return result
 }
 
+// FilterReturnWithError returns a filtered list based on a filter function that may fail.
+// It stops at the first error and returns it wrapped with the failing index.
+func FilterReturnWithError[T any](source []T, filterFunc func(item T) (bool, error)) ([]T, error) {
+	result := []T{}
+
+	for idx, item := range source {
+		keep, err := filterFunc(item)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error filtering at index:'%v', error", idx))
+		}
+		if keep {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
 // Exists checks if any element in the collection satisfies the condition.
 // T is a generic type parameter that can represent any type.
 func Exists[T any](collection []T, condition func(T) bool) bool {
@@ -178,6 +356,18 @@ func Exists[T any](collection []T, condition func(T) bool) bool {
 	return false
 }
 
+// ForAll checks if every element in the collection satisfies the condition.
+// It is true for an empty collection. It is conditional.ForAll, kept next to Exists and None.
+func ForAll[T any](collection []T, condition func(T) bool) bool {
+	return conditional.ForAll(collection, condition)
+}
+
+// None checks if no element in the collection satisfies the condition.
+// It is true for an empty collection.
+func None[T any](collection []T, condition func(T) bool) bool {
+	return !Exists(collection, condition)
+}
+
 // Generic function to find the highest value
 func Max[T constraints.Ordered](slice []T) (max T, found bool) {
 	if len(slice) == 0 {
@@ -305,6 +495,26 @@ func Chain[T any](value T, functions ...func(T) T) T {
 	return value
 }
 
+// ComposeAll combines functions into one that applies them from right to left, like nested Compose calls.
+// Unlike Chain it builds a reusable function instead of applying it immediately.
+// With no functions it returns the identity function.
+func ComposeAll[T any](functions ...func(T) T) func(T) T {
+	return func(value T) T {
+		for i := len(functions) - 1; i >= 0; i-- {
+			value = functions[i](value)
+		}
+		return value
+	}
+}
+
+// PipeAll combines functions into one that applies them from left to right, like Chain.
+// With no functions it returns the identity function.
+func PipeAll[T any](functions ...func(T) T) func(T) T {
+	return func(value T) T {
+		return Chain(value, functions...)
+	}
+}
+
 // MapFilter applies a map then filter function to a slice of any type
 func MapFilter[T any](input []T, mapFn func(T) T, filterFn func(T) bool) []T {
 	list := []T{}
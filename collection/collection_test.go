@@ -205,6 +205,77 @@ func TestHigherOrderFunction_Reduce(t *testing.T) {
 
 }
 
+func TestFold(t *testing.T) {
+	t.Run("Success_Summary", func(t *testing.T) {
+		type person struct {
+			Name string
+			Age  int
+		}
+		type summary struct {
+			Count    int
+			TotalAge int
+		}
+
+		source := []person{
+			{"Alice", 30},
+			{"Bob", 25},
+			{"Charlie", 35},
+		}
+
+		result := Fold(source, func(acc summary, p person) summary {
+			acc.Count++
+			acc.TotalAge += p.Age
+			return acc
+		}, summary{})
+
+		assert.Equal(t, summary{Count: 3, TotalAge: 90}, result)
+	})
+
+	t.Run("Success_IntsToString", func(t *testing.T) {
+		result := Fold([]int{1, 2, 3}, func(acc string, n int) string {
+			return acc + strconv.Itoa(n)
+		}, ">")
+		assert.Equal(t, ">123", result)
+	})
+
+	t.Run("Success_Empty_List", func(t *testing.T) {
+		result := Fold([]int{}, func(acc string, n int) string { return acc + strconv.Itoa(n) }, "init")
+		assert.Equal(t, "init", result)
+	})
+}
+
+func TestReduceWithError(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		source := []string{"1", "2", "3"}
+
+		reduceFunc := func(acc int, value string) (int, error) {
+			n, err := strconv.Atoi(value)
+			return acc + n, err
+		}
+
+		result, err := ReduceWithError(source, reduceFunc, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 16, result)
+	})
+
+	t.Run("some_element_has_Error", func(t *testing.T) {
+		calls := 0
+		reduceFunc := func(acc int, value int) (int, error) {
+			calls++
+			if value == 3 {
+				return 0, errors.New("fake error for 3")
+			}
+			return acc + value, nil
+		}
+
+		result, err := ReduceWithError([]int{1, 2, 3, 4}, reduceFunc, 0)
+		assert.Error(t, err)
+		assert.Equal(t, "error reducing at index:'2', error: fake error for 3", err.Error())
+		assert.Equal(t, 0, result)
+		assert.Equal(t, 3, calls)
+	})
+}
+
 func TestHigherOrderFunction_FlatMap(t *testing.T) {
 	t.Run("Success_Int", func(t *testing.T) {
 
@@ -235,6 +306,35 @@ func TestHigherOrderFunction_FlatMap(t *testing.T) {
 	})
 }
 
+func TestFlatMapFunc(t *testing.T) {
+	t.Run("Success_Expand", func(t *testing.T) {
+		type order struct {
+			Number string
+			Lines  []string
+		}
+
+		source := []order{
+			{"S1", []string{"apple", "kiwi"}},
+			{"S2", nil},
+			{"S3", []string{"melon"}},
+		}
+
+		result := FlatMapFunc(source, func(o order) []string { return o.Lines })
+		assert.Equal(t, []string{"apple", "kiwi", "melon"}, result)
+	})
+
+	t.Run("Success_Repeat", func(t *testing.T) {
+		result := FlatMapFunc([]int{1, 2, 3}, func(n int) []string {
+			return strings.Split(strings.Repeat(strconv.Itoa(n), n), "")
+		})
+		assert.Equal(t, []string{"1", "2", "2", "3", "3", "3"}, result)
+	})
+
+	t.Run("Success_Empty_List", func(t *testing.T) {
+		assert.Equal(t, []int{}, FlatMapFunc([]int{}, func(n int) []int { return []int{n} }))
+	})
+}
+
 func TestSum(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -532,6 +632,86 @@ func TestDistinctFunc(t *testing.T) {
 	}
 }
 
+func TestDistinctFuncCustomEquality(t *testing.T) {
+	t.Run("case-insensitive strings", func(t *testing.T) {
+		result := DistinctFunc([]string{"Go", "go", "Rust", "GO", "rust"}, strings.EqualFold)
+		assert.Equal(t, []string{"Go", "Rust"}, result)
+	})
+
+	t.Run("non-comparable elements", func(t *testing.T) {
+		source := [][]int{{1, 2}, {3}, {1, 2}, {}, {3}}
+		result := DistinctFunc(source, func(a, b []int) bool { return reflect.DeepEqual(a, b) })
+		assert.Equal(t, [][]int{{1, 2}, {3}, {}}, result)
+	})
+
+	t.Run("tolerance equality", func(t *testing.T) {
+		closeTo := func(a, b float64) bool { return a-b < 0.1 && b-a < 0.1 }
+		result := DistinctFunc([]float64{1.0, 1.05, 2.0, 1.95}, closeTo)
+		assert.Equal(t, []float64{1.0, 2.0}, result)
+	})
+}
+
+func TestDistinctFuncHashed(t *testing.T) {
+	t.Run("matches DistinctFunc", func(t *testing.T) {
+		source := []string{"Go", "go", "Rust", "GO", "rust", "Zig"}
+		result := DistinctFuncHashed(source, strings.EqualFold, strings.ToLower)
+		assert.Equal(t, DistinctFunc(source, strings.EqualFold), result)
+		assert.Equal(t, []string{"Go", "Rust", "Zig"}, result)
+	})
+
+	t.Run("equal decides within a bucket", func(t *testing.T) {
+		calls := 0
+		sameLength := func(s string) int { return len(s) }
+		result := DistinctFuncHashed([]string{"ab", "cd", "ab", "xyz"}, func(a, b string) bool {
+			calls++
+			return a == b
+		}, sameLength)
+		assert.Equal(t, []string{"ab", "cd", "xyz"}, result)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, DistinctFuncHashed([]int{}, func(a, b int) bool { return a == b }, func(n int) int { return n }))
+	})
+}
+
+func TestDistinctBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	t.Run("keeps first element per key", func(t *testing.T) {
+		users := []user{{1, "a"}, {2, "b"}, {1, "c"}}
+		result := DistinctBy(users, func(u user) int { return u.ID })
+		assert.Equal(t, []user{{1, "a"}, {2, "b"}}, result)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		result := DistinctBy([]user{}, func(u user) int { return u.ID })
+		assert.Equal(t, []user{}, result)
+	})
+
+	t.Run("matches DistinctFunc", func(t *testing.T) {
+		source := []string{"Go", "go", "Rust", "GO", "rust"}
+		assert.Equal(t, DistinctFunc(source, strings.EqualFold), DistinctBy(source, strings.ToLower))
+	})
+}
+
+func TestMapIf(t *testing.T) {
+	t.Run("applies only to matching elements", func(t *testing.T) {
+		source := []int{1, -2, 3, -4}
+		result := MapIf(source, func(n int) bool { return n < 0 }, func(n int) int { return -n })
+		assert.Equal(t, []int{1, 2, 3, 4}, result)
+		assert.Equal(t, []int{1, -2, 3, -4}, source)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		result := MapIf([]string{}, func(string) bool { return true }, strings.ToUpper)
+		assert.Equal(t, []string{}, result)
+	})
+}
+
 func TestFilter(t *testing.T) {
 	t.Run("filter > 3", func(t *testing.T) {
 
@@ -559,6 +739,37 @@ func TestFilter(t *testing.T) {
 	})
 }
 
+func TestFilterReturnWithError(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		source := []string{"1", "20", "3", "40"}
+
+		filterFunc := func(data string) (bool, error) {
+			n, err := strconv.Atoi(data)
+			return n >= 10, err
+		}
+
+		result, err := FilterReturnWithError(source, filterFunc)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"20", "40"}, result)
+	})
+
+	t.Run("some_element_has_Error", func(t *testing.T) {
+		source := []int{1, 2, 3, 4, 5}
+
+		filterFunc := func(data int) (bool, error) {
+			if data == 3 {
+				return false, errors.New("fake error for 3")
+			}
+			return true, nil
+		}
+
+		result, err := FilterReturnWithError(source, filterFunc)
+		assert.Error(t, err)
+		assert.Equal(t, "error filtering at index:'2', error: fake error for 3", err.Error())
+		assert.Nil(t, result)
+	})
+}
+
 func TestForEach(t *testing.T) {
 	t.Run("print integers", func(t *testing.T) {
 
@@ -665,6 +876,55 @@ func TestForEachWithError(t *testing.T) {
 	})
 }
 
+func TestForEachCollectErrors(t *testing.T) {
+	t.Run("runs every item and joins failures", func(t *testing.T) {
+		visited := []int{}
+		err := ForEachCollectErrors([]int{1, 2, 3, 4}, func(item int) error {
+			visited = append(visited, item)
+			if item%2 == 0 {
+				return fmt.Errorf("even %d", item)
+			}
+			return nil
+		})
+
+		assert.Equal(t, []int{1, 2, 3, 4}, visited)
+		assert.EqualError(t, err, "error at index:'1', error: even 2\nerror at index:'3', error: even 4")
+	})
+
+	t.Run("no failures", func(t *testing.T) {
+		err := ForEachCollectErrors([]int{1, 2}, func(item int) error { return nil })
+		assert.NoError(t, err)
+	})
+}
+
+func TestForEachWithErrorOptions(t *testing.T) {
+	failOnTwo := func(visited *[]int) func(int) error {
+		return func(item int) error {
+			*visited = append(*visited, item)
+			if item == 2 {
+				return errors.New("boom")
+			}
+			return nil
+		}
+	}
+
+	t.Run("stops at first error by default", func(t *testing.T) {
+		visited := []int{}
+		err := ForEachWithErrorOptions([]int{1, 2, 3}, failOnTwo(&visited), ForEachOptions{})
+
+		assert.EqualError(t, err, "boom")
+		assert.Equal(t, []int{1, 2}, visited)
+	})
+
+	t.Run("continues on error", func(t *testing.T) {
+		visited := []int{}
+		err := ForEachWithErrorOptions([]int{1, 2, 3}, failOnTwo(&visited), ForEachOptions{ContinueOnError: true})
+
+		assert.EqualError(t, err, "error at index:'1', error: boom")
+		assert.Equal(t, []int{1, 2, 3}, visited)
+	})
+}
+
 func TestCloneStringList(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -743,6 +1003,57 @@ func TestMapReturnWithError(t *testing.T) {
 
 }
 
+func TestMapCollectErrors(t *testing.T) {
+	t.Run("all items succeed", func(t *testing.T) {
+		result, err := MapCollectErrors([]string{"1", "2", "3"}, strconv.Atoi)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+
+	t.Run("failures are collected and successes kept", func(t *testing.T) {
+		result, err := MapCollectErrors([]string{"1", "x", "3", "y"}, strconv.Atoi)
+
+		assert.Equal(t, []int{1, 3}, result)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "error mapping at index:'1'")
+		assert.Contains(t, err.Error(), "error mapping at index:'3'")
+
+		var numErr *strconv.NumError
+		assert.True(t, errors.As(err, &numErr))
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		result, err := MapCollectErrors([]string{}, strconv.Atoi)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, result)
+	})
+}
+
+func TestMapReturnWithErrorOptions(t *testing.T) {
+	failOnOdd := func(item int) (int, error) {
+		if item%2 == 1 {
+			return 0, fmt.Errorf("odd %d", item)
+		}
+		return item * 10, nil
+	}
+
+	t.Run("stops at first error by default", func(t *testing.T) {
+		result, err := MapReturnWithErrorOptions([]int{2, 3, 5}, failOnOdd, MapOptions{})
+
+		assert.EqualError(t, err, "error mapping at index:'1', error: odd 3")
+		assert.Nil(t, result)
+	})
+
+	t.Run("continues on error", func(t *testing.T) {
+		result, err := MapReturnWithErrorOptions([]int{2, 3, 4, 5}, failOnOdd, MapOptions{ContinueOnError: true})
+
+		assert.EqualError(t, err, "error mapping at index:'1', error: odd 3\nerror mapping at index:'3', error: odd 5")
+		assert.Equal(t, []int{20, 40}, result)
+	})
+}
+
 func TestHigherOrderFunction_Sort(t *testing.T) {
 	t.Run("Success_Int", func(t *testing.T) {
 
@@ -923,6 +1234,47 @@ func TestExists(t *testing.T) {
 }
 
 // Test for Max function
+func TestForAll(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     []int
+		condition func(int) bool
+		expected  bool
+	}{
+		{
+			name:      "All elements positive",
+			input:     []int{1, 2, 3},
+			condition: func(n int) bool { return n > 0 },
+			expected:  true,
+		},
+		{
+			name:      "One element not positive",
+			input:     []int{1, -2, 3},
+			condition: func(n int) bool { return n > 0 },
+			expected:  false,
+		},
+		{
+			name:      "Empty slice",
+			input:     []int{},
+			condition: func(n int) bool { return n > 0 },
+			expected:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ForAll(tc.input, tc.condition))
+		})
+	}
+}
+
+func TestNone(t *testing.T) {
+	greaterThan10 := func(n int) bool { return n > 10 }
+	assert.True(t, None([]int{1, 2, 3}, greaterThan10))
+	assert.False(t, None([]int{1, 11}, greaterThan10))
+	assert.True(t, None([]int{}, greaterThan10))
+}
+
 func TestMax(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -1678,6 +2030,29 @@ func TestChain(t *testing.T) {
 	})
 }
 
+func TestComposeAllAndPipeAll(t *testing.T) {
+	increment := func(x int) int { return x + 1 }
+	double := func(x int) int { return x * 2 }
+	subtractTwo := func(x int) int { return x - 2 }
+
+	t.Run("ComposeAll applies right to left", func(t *testing.T) {
+		fn := ComposeAll(subtractTwo, double, increment)
+		assert.Equal(t, 6, fn(3)) // ((3 + 1) * 2) - 2
+		assert.Equal(t, Compose(subtractTwo, Compose(double, increment))(5), fn(5))
+	})
+
+	t.Run("PipeAll applies left to right", func(t *testing.T) {
+		fn := PipeAll(increment, double, subtractTwo)
+		assert.Equal(t, 6, fn(3))
+		assert.Equal(t, Chain(5, increment, double, subtractTwo), fn(5))
+	})
+
+	t.Run("no functions is identity", func(t *testing.T) {
+		assert.Equal(t, "same", ComposeAll[string]()("same"))
+		assert.Equal(t, "same", PipeAll[string]()("same"))
+	})
+}
+
 func TestMapFilter(t *testing.T) {
 
 	t.Run("TestMapFilter with integers - doubling and filtering even numbers", func(t *testing.T) {
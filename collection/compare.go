@@ -0,0 +1,106 @@
+package collection
+
+import "sort"
+
+// Comparable is implemented by types with a natural ordering.
+// Compare returns a negative number when the receiver sorts before other, zero when they are equal
+// and a positive number otherwise. time.Time satisfies Comparable[time.Time].
+type Comparable[T any] interface {
+	Compare(other T) int
+}
+
+// MaxComparable returns the greatest element according to its Compare method.
+func MaxComparable[T Comparable[T]](slice []T) (max T, found bool) {
+	return MaxOrderedBy(slice, func(item T) T { return item })
+}
+
+// MinComparable returns the smallest element according to its Compare method.
+func MinComparable[T Comparable[T]](slice []T) (min T, found bool) {
+	return MinOrderedBy(slice, func(item T) T { return item })
+}
+
+// MaxOrderedBy returns the element whose key, extracted by getter, is the greatest.
+// Example:
+//   - MaxOrderedBy(events, func(e Event) time.Time { return e.At }) returns the latest event.
+func MaxOrderedBy[T any, K Comparable[K]](slice []T, getter func(T) K) (max T, found bool) {
+	if len(slice) == 0 {
+		return max, false
+	}
+
+	max = slice[0]
+	maxKey := getter(slice[0])
+	for _, v := range slice[1:] {
+		key := getter(v)
+		if key.Compare(maxKey) > 0 {
+			max = v
+			maxKey = key
+		}
+	}
+	return max, true
+}
+
+// MinOrderedBy returns the element whose key, extracted by getter, is the smallest.
+func MinOrderedBy[T any, K Comparable[K]](slice []T, getter func(T) K) (min T, found bool) {
+	if len(slice) == 0 {
+		return min, false
+	}
+
+	min = slice[0]
+	minKey := getter(slice[0])
+	for _, v := range slice[1:] {
+		key := getter(v)
+		if key.Compare(minKey) < 0 {
+			min = v
+			minKey = key
+		}
+	}
+	return min, true
+}
+
+// SortComparable sorts a slice in ascending order using its Compare method.
+// The sort is stable, and the slice is sorted in place and returned.
+func SortComparable[T Comparable[T]](list []T) []T {
+	return SortComparableBy(list, func(item T) T { return item })
+}
+
+// SortComparableBy sorts a slice in ascending order of the key extracted by getter.
+// The sort is stable, and the slice is sorted in place and returned.
+func SortComparableBy[T any, K Comparable[K]](list []T, getter func(T) K) []T {
+	sort.SliceStable(list, func(i, j int) bool {
+		return getter(list[i]).Compare(getter(list[j])) < 0
+	})
+	return list
+}
+
+// MaxWith returns the greatest element according to cmp, which returns a negative number when a
+// sorts before b, zero when they are equal and a positive number otherwise. Ties keep the first element.
+// Example:
+//   - MaxWith(people, func(a, b Person) int { ... compare by age, then by name ... })
+func MaxWith[T any](slice []T, cmp func(a, b T) int) (max T, found bool) {
+	if len(slice) == 0 {
+		return max, false
+	}
+
+	max = slice[0]
+	for _, v := range slice[1:] {
+		if cmp(v, max) > 0 {
+			max = v
+		}
+	}
+	return max, true
+}
+
+// MinWith returns the smallest element according to cmp. Ties keep the first element.
+func MinWith[T any](slice []T, cmp func(a, b T) int) (min T, found bool) {
+	if len(slice) == 0 {
+		return min, false
+	}
+
+	min = slice[0]
+	for _, v := range slice[1:] {
+		if cmp(v, min) < 0 {
+			min = v
+		}
+	}
+	return min, true
+}
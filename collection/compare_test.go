@@ -0,0 +1,140 @@
+package collection
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type version struct {
+	major, minor int
+}
+
+func (v version) Compare(other version) int {
+	if v.major != other.major {
+		return v.major - other.major
+	}
+	return v.minor - other.minor
+}
+
+func TestMaxMinComparable(t *testing.T) {
+	versions := []version{{1, 2}, {2, 0}, {1, 10}, {0, 9}}
+
+	t.Run("custom type", func(t *testing.T) {
+		max, found := MaxComparable(versions)
+		assert.True(t, found)
+		assert.Equal(t, version{2, 0}, max)
+
+		min, found := MinComparable(versions)
+		assert.True(t, found)
+		assert.Equal(t, version{0, 9}, min)
+	})
+
+	t.Run("time.Time", func(t *testing.T) {
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		times := []time.Time{base.Add(time.Hour), base, base.Add(-time.Hour)}
+
+		max, _ := MaxComparable(times)
+		assert.Equal(t, base.Add(time.Hour), max)
+		min, _ := MinComparable(times)
+		assert.Equal(t, base.Add(-time.Hour), min)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		_, found := MaxComparable([]version{})
+		assert.False(t, found)
+		_, found = MinComparable([]version{})
+		assert.False(t, found)
+	})
+}
+
+func TestOrderedBy(t *testing.T) {
+	type event struct {
+		Name string
+		At   time.Time
+	}
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []event{
+		{"b", base.Add(2 * time.Hour)},
+		{"a", base},
+		{"c", base.Add(time.Hour)},
+	}
+	at := func(e event) time.Time { return e.At }
+
+	t.Run("MaxOrderedBy", func(t *testing.T) {
+		latest, found := MaxOrderedBy(events, at)
+		assert.True(t, found)
+		assert.Equal(t, "b", latest.Name)
+	})
+
+	t.Run("MinOrderedBy", func(t *testing.T) {
+		earliest, found := MinOrderedBy(events, at)
+		assert.True(t, found)
+		assert.Equal(t, "a", earliest.Name)
+	})
+
+	t.Run("SortComparableBy", func(t *testing.T) {
+		sorted := SortComparableBy(CloneList(events), at)
+		assert.Equal(t, []string{"a", "c", "b"}, Map(sorted, func(e event) string { return e.Name }))
+	})
+}
+
+func TestSortComparable(t *testing.T) {
+	t.Run("sorts ascending", func(t *testing.T) {
+		result := SortComparable([]version{{1, 2}, {2, 0}, {1, 10}, {0, 9}})
+		assert.Equal(t, []version{{0, 9}, {1, 2}, {1, 10}, {2, 0}}, result)
+	})
+
+	t.Run("stable for equal elements", func(t *testing.T) {
+		type tagged struct {
+			version version
+			tag     string
+		}
+		items := []tagged{{version{1, 0}, "x"}, {version{0, 1}, "y"}, {version{1, 0}, "z"}}
+		result := SortComparableBy(items, func(item tagged) version { return item.version })
+		assert.Equal(t, []string{"y", "x", "z"}, Map(result, func(item tagged) string { return item.tag }))
+	})
+}
+
+func TestMaxMinWith(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	people := []person{{"bob", 30}, {"alice", 30}, {"carol", 25}, {"dave", 25}}
+	byAgeThenName := func(a, b person) int {
+		if a.Age != b.Age {
+			return a.Age - b.Age
+		}
+		return strings.Compare(a.Name, b.Name)
+	}
+
+	t.Run("MaxWith", func(t *testing.T) {
+		max, found := MaxWith(people, byAgeThenName)
+		assert.True(t, found)
+		assert.Equal(t, person{"bob", 30}, max)
+	})
+
+	t.Run("MinWith", func(t *testing.T) {
+		min, found := MinWith(people, byAgeThenName)
+		assert.True(t, found)
+		assert.Equal(t, person{"carol", 25}, min)
+	})
+
+	t.Run("ties keep the first element", func(t *testing.T) {
+		byAge := func(a, b person) int { return a.Age - b.Age }
+		max, _ := MaxWith(people, byAge)
+		assert.Equal(t, "bob", max.Name)
+		min, _ := MinWith(people, byAge)
+		assert.Equal(t, "carol", min.Name)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		_, found := MaxWith([]person{}, byAgeThenName)
+		assert.False(t, found)
+		_, found = MinWith(nil, byAgeThenName)
+		assert.False(t, found)
+	})
+}
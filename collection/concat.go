@@ -0,0 +1,26 @@
+package collection
+
+// Concat returns a new slice holding the elements of every slice in order, allocated once.
+// The inputs are never modified or aliased.
+func Concat[T any](slices ...[]T) []T {
+	total := 0
+	for _, s := range slices {
+		total += len(s)
+	}
+	result := make([]T, 0, total)
+	for _, s := range slices {
+		result = append(result, s...)
+	}
+	return result
+}
+
+// Prepend returns a new slice with items followed by the elements of source.
+func Prepend[T any](source []T, items ...T) []T {
+	return Concat(items, source)
+}
+
+// AppendAll returns a new slice with the elements of source followed by items.
+// Unlike append, the result never shares the backing array of source.
+func AppendAll[T any](source []T, items ...T) []T {
+	return Concat(source, items)
+}
@@ -0,0 +1,43 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcat(t *testing.T) {
+	t.Run("joins in order", func(t *testing.T) {
+		result := Concat([]int{1, 2}, nil, []int{3}, []int{4, 5})
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, result)
+		assert.Equal(t, 5, cap(result))
+	})
+
+	t.Run("no inputs", func(t *testing.T) {
+		assert.Equal(t, []int{}, Concat[int]())
+	})
+}
+
+func TestPrepend(t *testing.T) {
+	source := []string{"c"}
+	assert.Equal(t, []string{"a", "b", "c"}, Prepend(source, "a", "b"))
+	assert.Equal(t, []string{"c"}, source)
+}
+
+func TestAppendAll(t *testing.T) {
+	t.Run("does not alias spare capacity", func(t *testing.T) {
+		source := make([]int, 2, 10)
+		first := AppendAll(source, 1)
+		second := AppendAll(source, 2)
+		assert.Equal(t, []int{0, 0, 1}, first)
+		assert.Equal(t, []int{0, 0, 2}, second)
+		assert.Equal(t, []int{0, 0}, source)
+	})
+
+	t.Run("result is independent of the source", func(t *testing.T) {
+		source := []int{1}
+		result := AppendAll(source)
+		result[0] = 9
+		assert.Equal(t, []int{1}, source)
+	})
+}
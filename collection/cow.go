@@ -0,0 +1,96 @@
+package collection
+
+// CowSlice is an immutable, copy-on-write view over a slice.
+// Sub, Append without items and Filter keeping one contiguous run share the underlying array;
+// Set, Map and the other Append and Filter results always copy it, even when nothing changes.
+type CowSlice[T any] struct {
+	data []T
+}
+
+// NewCowSlice wraps source without copying it; the caller must not modify source afterwards.
+func NewCowSlice[T any](source []T) CowSlice[T] {
+	return CowSlice[T]{data: source[:len(source):len(source)]}
+}
+
+// Len returns the number of elements.
+func (c CowSlice[T]) Len() int {
+	return len(c.data)
+}
+
+// Get returns the element at index i.
+func (c CowSlice[T]) Get(i int) T {
+	return c.data[i]
+}
+
+// ToSlice returns a copy of the elements that the caller may freely modify.
+func (c CowSlice[T]) ToSlice() []T {
+	return CloneList(c.data)
+}
+
+// ForEach executes a function for each element.
+func (c CowSlice[T]) ForEach(action func(item T)) {
+	ForEach(c.data, action)
+}
+
+// Sub returns the elements in [from, to) sharing the same underlying array.
+func (c CowSlice[T]) Sub(from, to int) CowSlice[T] {
+	return CowSlice[T]{data: c.data[from:to:to]}
+}
+
+// Append returns a new handle with items appended; the receiver is unchanged.
+func (c CowSlice[T]) Append(items ...T) CowSlice[T] {
+	if len(items) == 0 {
+		return c
+	}
+	// The capacity of c.data always equals its length, so append copies instead of aliasing.
+	data := append(c.data, items...)
+	return CowSlice[T]{data: data[:len(data):len(data)]}
+}
+
+// Set returns a new handle with the element at index i replaced.
+func (c CowSlice[T]) Set(i int, value T) CowSlice[T] {
+	data := CloneList(c.data)
+	data[i] = value
+	return CowSlice[T]{data: data}
+}
+
+// Map returns a new handle with the transformation applied to each element.
+func (c CowSlice[T]) Map(transform func(item T) T) CowSlice[T] {
+	data := make([]T, len(c.data))
+	for i, item := range c.data {
+		data[i] = transform(item)
+	}
+	return CowSlice[T]{data: data}
+}
+
+// Filter returns a handle with the elements satisfying the predicate.
+// When the kept elements form one contiguous run, the underlying array is shared instead of copied.
+func (c CowSlice[T]) Filter(predicate func(item T) bool) CowSlice[T] {
+	keep := make([]bool, len(c.data))
+	start, end, kept := -1, -1, 0
+	for i, item := range c.data {
+		if !predicate(item) {
+			continue
+		}
+		keep[i] = true
+		kept++
+		if start == -1 {
+			start = i
+		}
+		end = i + 1
+	}
+	if kept == 0 {
+		return CowSlice[T]{data: []T{}}
+	}
+	if kept == end-start {
+		return c.Sub(start, end)
+	}
+
+	data := make([]T, 0, kept)
+	for i, item := range c.data {
+		if keep[i] {
+			data = append(data, item)
+		}
+	}
+	return CowSlice[T]{data: data}
+}
@@ -0,0 +1,75 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCowSlice(t *testing.T) {
+	t.Run("shares the source until modified", func(t *testing.T) {
+		source := []int{1, 2, 3}
+		cow := NewCowSlice(source)
+
+		assert.Equal(t, 3, cow.Len())
+		assert.Equal(t, 2, cow.Get(1))
+		assert.Same(t, &source[0], &cow.data[0])
+	})
+
+	t.Run("append never aliases other handles", func(t *testing.T) {
+		base := NewCowSlice(make([]int, 2, 10))
+		first := base.Append(1)
+		second := base.Append(2)
+
+		assert.Equal(t, []int{0, 0, 1}, first.ToSlice())
+		assert.Equal(t, []int{0, 0, 2}, second.ToSlice())
+		assert.Equal(t, 2, base.Len())
+	})
+
+	t.Run("set copies", func(t *testing.T) {
+		source := []string{"a", "b"}
+		updated := NewCowSlice(source).Set(0, "z")
+
+		assert.Equal(t, []string{"z", "b"}, updated.ToSlice())
+		assert.Equal(t, []string{"a", "b"}, source)
+	})
+
+	t.Run("map returns a new handle", func(t *testing.T) {
+		cow := NewCowSlice([]int{1, 2, 3})
+		doubled := cow.Map(func(item int) int { return item * 2 })
+
+		assert.Equal(t, []int{2, 4, 6}, doubled.ToSlice())
+		assert.Equal(t, []int{1, 2, 3}, cow.ToSlice())
+	})
+
+	t.Run("filter shares contiguous runs", func(t *testing.T) {
+		source := []int{1, 2, 3, 4, 5}
+		cow := NewCowSlice(source)
+
+		middle := cow.Filter(func(item int) bool { return item >= 2 && item <= 4 })
+		assert.Equal(t, []int{2, 3, 4}, middle.ToSlice())
+		assert.Same(t, &source[1], &middle.data[0])
+
+		odd := cow.Filter(func(item int) bool { return item%2 == 1 })
+		assert.Equal(t, []int{1, 3, 5}, odd.ToSlice())
+		assert.NotSame(t, &source[0], &odd.data[0])
+
+		assert.Equal(t, []int{}, cow.Filter(func(item int) bool { return false }).ToSlice())
+	})
+
+	t.Run("appending to a filtered view does not overwrite the source", func(t *testing.T) {
+		source := []int{1, 2, 3}
+		prefix := NewCowSlice(source).Filter(func(item int) bool { return item < 3 })
+
+		prefix.Append(99)
+		assert.Equal(t, []int{1, 2, 3}, source)
+	})
+
+	t.Run("ToSlice returns an independent copy", func(t *testing.T) {
+		cow := NewCowSlice([]int{1})
+		copied := cow.ToSlice()
+		copied[0] = 5
+
+		assert.Equal(t, 1, cow.Get(0))
+	})
+}
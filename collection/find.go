@@ -0,0 +1,47 @@
+package collection
+
+// Find returns the first element satisfying the predicate and true, or the zero value and false.
+// Example:
+//   - Find(orders, isOverdue) returns the first overdue order.
+func Find[T any](source []T, predicate func(item T) bool) (found T, ok bool) {
+	if idx := FindIndex(source, predicate); idx >= 0 {
+		return source[idx], true
+	}
+	return found, false
+}
+
+// FindIndex returns the index of the first element satisfying the predicate, or -1 if there is none.
+func FindIndex[T any](source []T, predicate func(item T) bool) int {
+	for i, item := range source {
+		if predicate(item) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLast returns the last element satisfying the predicate and true, or the zero value and false.
+func FindLast[T any](source []T, predicate func(item T) bool) (found T, ok bool) {
+	for i := len(source) - 1; i >= 0; i-- {
+		if predicate(source[i]) {
+			return source[i], true
+		}
+	}
+	return found, false
+}
+
+// Contains reports whether source holds an element equal to target.
+func Contains[T comparable](source []T, target T) bool {
+	for _, item := range source {
+		if item == target {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsBy reports whether source holds an element satisfying the predicate. It is an alias of Exists
+// that reads better next to Contains.
+func ContainsBy[T any](source []T, predicate func(item T) bool) bool {
+	return Exists(source, predicate)
+}
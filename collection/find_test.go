@@ -0,0 +1,47 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	t.Run("Find", func(t *testing.T) {
+		found, ok := Find([]int{1, 2, 3, 4}, isEven)
+		assert.True(t, ok)
+		assert.Equal(t, 2, found)
+
+		found, ok = Find([]int{1, 3}, isEven)
+		assert.False(t, ok)
+		assert.Equal(t, 0, found)
+	})
+
+	t.Run("FindIndex", func(t *testing.T) {
+		assert.Equal(t, 1, FindIndex([]int{1, 2, 3, 4}, isEven))
+		assert.Equal(t, -1, FindIndex([]int{1, 3}, isEven))
+		assert.Equal(t, -1, FindIndex([]int{}, isEven))
+	})
+
+	t.Run("FindLast", func(t *testing.T) {
+		found, ok := FindLast([]int{1, 2, 3, 4, 5}, isEven)
+		assert.True(t, ok)
+		assert.Equal(t, 4, found)
+
+		_, ok = FindLast([]int{}, isEven)
+		assert.False(t, ok)
+	})
+}
+
+func TestContains(t *testing.T) {
+	assert.True(t, Contains([]string{"a", "b"}, "b"))
+	assert.False(t, Contains([]string{"a", "b"}, "c"))
+	assert.False(t, Contains([]string{}, ""))
+
+	type invoiceLine struct{ SKU string }
+	lines := []invoiceLine{{SKU: "A-1"}, {SKU: "B-2"}}
+	assert.True(t, ContainsBy(lines, func(l invoiceLine) bool { return l.SKU == "B-2" }))
+	assert.False(t, ContainsBy(lines, func(l invoiceLine) bool { return l.SKU == "C-3" }))
+}
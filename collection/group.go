@@ -0,0 +1,37 @@
+package collection
+
+// GroupBy groups the elements of source by the key returned by keyFunc, keeping their order.
+// Unlike grouping.GroupBy it needs no reflection and is checked at compile time.
+// Example:
+//   - GroupBy(orders, func(o Order) string { return o.CustomerCode })
+func GroupBy[T any, K comparable](source []T, keyFunc func(item T) K) map[K][]T {
+	return GroupByMap(source, keyFunc, func(item T) T { return item })
+}
+
+// GroupByMap groups the elements of source by key and stores valueFunc(element) in each group.
+// Example:
+//   - GroupByMap(orders, customerCode, func(o Order) string { return o.SalesOrderNumber })
+func GroupByMap[T any, K comparable, V any](source []T, keyFunc func(item T) K, valueFunc func(item T) V) map[K][]V {
+	result := make(map[K][]V)
+	for _, item := range source {
+		key := keyFunc(item)
+		result[key] = append(result[key], valueFunc(item))
+	}
+	return result
+}
+
+// CountBy returns how many elements of source fall under each key returned by keyFunc.
+// Example:
+//   - CountBy(orders, func(o Order) string { return o.Status }) returns map[open:3 shipped:5].
+func CountBy[T any, K comparable](source []T, keyFunc func(item T) K) map[K]int {
+	result := make(map[K]int)
+	for _, item := range source {
+		result[keyFunc(item)]++
+	}
+	return result
+}
+
+// Frequencies returns how many times each distinct element occurs in source.
+func Frequencies[T comparable](source []T) map[T]int {
+	return CountBy(source, func(item T) T { return item })
+}
@@ -0,0 +1,59 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type salesOrder struct {
+	CustomerCode     string
+	SalesOrderNumber string
+	Amount           float64
+}
+
+var salesOrders = []salesOrder{
+	{"C2", "S2", 200},
+	{"C1", "S3", 300},
+	{"C2", "S4", 400},
+	{"C1", "S1", 100},
+}
+
+func customerCode(o salesOrder) string { return o.CustomerCode }
+
+func TestGroupBy(t *testing.T) {
+	t.Run("groups by key in source order", func(t *testing.T) {
+		result := GroupBy(salesOrders, customerCode)
+		assert.Equal(t, map[string][]salesOrder{
+			"C1": {{"C1", "S3", 300}, {"C1", "S1", 100}},
+			"C2": {{"C2", "S2", 200}, {"C2", "S4", 400}},
+		}, result)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		assert.Equal(t, map[string][]salesOrder{}, GroupBy([]salesOrder{}, customerCode))
+	})
+}
+
+func TestGroupByMap(t *testing.T) {
+	result := GroupByMap(salesOrders, customerCode, func(o salesOrder) string { return o.SalesOrderNumber })
+	assert.Equal(t, map[string][]string{
+		"C1": {"S3", "S1"},
+		"C2": {"S2", "S4"},
+	}, result)
+}
+
+func TestCountBy(t *testing.T) {
+	counts := CountBy(salesOrders, func(o salesOrder) string { return o.CustomerCode })
+	assert.Equal(t, map[string]int{"C1": 2, "C2": 2}, counts)
+
+	bySize := CountBy(salesOrders, func(o salesOrder) bool { return o.Amount >= 300 })
+	assert.Equal(t, map[bool]int{true: 2, false: 2}, bySize)
+
+	assert.Empty(t, CountBy([]salesOrder{}, func(o salesOrder) string { return o.CustomerCode }))
+}
+
+func TestFrequencies(t *testing.T) {
+	assert.Equal(t, map[string]int{"a": 2, "b": 1}, Frequencies([]string{"a", "b", "a"}))
+	assert.Equal(t, map[int]int{}, Frequencies([]int{}))
+}
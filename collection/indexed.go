@@ -0,0 +1,32 @@
+package collection
+
+// MapIndexed is like Map but the transformation function also receives the index of each item.
+// Example:
+//   - MapIndexed(names, func(i int, name string) string { return fmt.Sprintf("%d. %s", i+1, name) })
+func MapIndexed[T1 any, T2 any](source []T1, transform func(index int, item T1) T2) []T2 {
+	result := make([]T2, len(source))
+	for i, item := range source {
+		result[i] = transform(i, item)
+	}
+	return result
+}
+
+// FilterIndexed is like Filter but the filter function also receives the index of each item.
+// Example:
+//   - FilterIndexed(records, func(i int, _ []string) bool { return i > 0 }) skips a header row.
+func FilterIndexed[T any](source []T, filterFunc func(index int, item T) bool) []T {
+	result := []T{}
+	for i, item := range source {
+		if filterFunc(i, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ForEachIndexed is like ForEach but the action also receives the index of each item.
+func ForEachIndexed[T any](source []T, action func(index int, item T)) {
+	for i, item := range source {
+		action(i, item)
+	}
+}
@@ -0,0 +1,38 @@
+package collection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapIndexed(t *testing.T) {
+	result := MapIndexed([]string{"ann", "bob"}, func(i int, name string) string {
+		return fmt.Sprintf("%d. %s", i+1, name)
+	})
+	assert.Equal(t, []string{"1. ann", "2. bob"}, result)
+	assert.Equal(t, []int{}, MapIndexed([]int{}, func(i int, n int) int { return i + n }))
+}
+
+func TestFilterIndexed(t *testing.T) {
+	records := [][]string{{"name"}, {"ann"}, {"bob"}}
+	rows := FilterIndexed(records, func(i int, _ []string) bool { return i > 0 })
+	assert.Equal(t, [][]string{{"ann"}, {"bob"}}, rows)
+
+	source := []int{1, 1, 2, 2, 2, 3}
+	deduped := FilterIndexed(source, func(i int, n int) bool { return i == 0 || source[i-1] != n })
+	assert.Equal(t, []int{1, 2, 3}, deduped)
+}
+
+func TestForEachIndexed(t *testing.T) {
+	styles := []string{}
+	ForEachIndexed([]string{"a", "b", "c"}, func(i int, row string) {
+		if i%2 == 0 {
+			styles = append(styles, row+":even")
+		} else {
+			styles = append(styles, row+":odd")
+		}
+	})
+	assert.Equal(t, []string{"a:even", "b:odd", "c:even"}, styles)
+}
@@ -0,0 +1,69 @@
+package collection
+
+import "errors"
+
+// ErrEmptySlice is returned by NewNonEmptySlice for an empty input.
+var ErrEmptySlice = errors.New("empty slice")
+
+// NonEmptySlice is a slice that is known to hold at least one element, so Head, Last, Max, Min
+// and Reduce always have a result. The zero value is not valid; create one with NewNonEmptySlice
+// or NonEmptyOf.
+type NonEmptySlice[T any] struct {
+	items []T
+}
+
+// NewNonEmptySlice copies source into a NonEmptySlice, or returns ErrEmptySlice when it is empty.
+func NewNonEmptySlice[T any](source []T) (NonEmptySlice[T], error) {
+	if len(source) == 0 {
+		return NonEmptySlice[T]{}, ErrEmptySlice
+	}
+	return NonEmptySlice[T]{items: CloneList(source)}, nil
+}
+
+// NonEmptyOf creates a NonEmptySlice from a required first element and any further elements.
+func NonEmptyOf[T any](head T, tail ...T) NonEmptySlice[T] {
+	return NonEmptySlice[T]{items: Prepend(tail, head)}
+}
+
+// Head returns the first element.
+func (s NonEmptySlice[T]) Head() T {
+	return s.items[0]
+}
+
+// Last returns the last element.
+func (s NonEmptySlice[T]) Last() T {
+	return s.items[len(s.items)-1]
+}
+
+// Tail returns every element after the first, which may be none.
+func (s NonEmptySlice[T]) Tail() []T {
+	return CloneList(s.items[1:])
+}
+
+// Len returns the number of elements, which is at least one.
+func (s NonEmptySlice[T]) Len() int {
+	return len(s.items)
+}
+
+// Slice returns a copy of the elements as a plain slice.
+func (s NonEmptySlice[T]) Slice() []T {
+	return CloneList(s.items)
+}
+
+// Max returns the greatest element according to cmp, like MaxWith. Ties keep the first element.
+func (s NonEmptySlice[T]) Max(cmp func(a, b T) int) T {
+	max, _ := MaxWith(s.items, cmp)
+	return max
+}
+
+// Min returns the smallest element according to cmp, like MinWith. Ties keep the first element.
+func (s NonEmptySlice[T]) Min(cmp func(a, b T) int) T {
+	min, _ := MinWith(s.items, cmp)
+	return min
+}
+
+// Reduce folds the elements from left to right, starting with the first element,
+// so no initial value is needed.
+func (s NonEmptySlice[T]) Reduce(reduceFunc func(acc T, item T) T) T {
+	return Reduce(s.items[1:], reduceFunc, s.items[0])
+}
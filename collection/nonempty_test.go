@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNonEmptySlice(t *testing.T) {
+	_, err := NewNonEmptySlice([]int{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+
+	source := []int{3, 1, 2}
+	s, err := NewNonEmptySlice(source)
+	assert.NoError(t, err)
+	source[0] = 99
+	assert.Equal(t, []int{3, 1, 2}, s.Slice(), "the source is copied")
+}
+
+func TestNonEmptySlice(t *testing.T) {
+	s := NonEmptyOf(3, 1, 4, 1, 5)
+
+	assert.Equal(t, 3, s.Head())
+	assert.Equal(t, 5, s.Last())
+	assert.Equal(t, []int{1, 4, 1, 5}, s.Tail())
+	assert.Equal(t, 5, s.Len())
+	assert.Equal(t, 5, s.Max(cmp.Compare[int]))
+	assert.Equal(t, 1, s.Min(cmp.Compare[int]))
+	assert.Equal(t, 14, s.Reduce(func(acc, n int) int { return acc + n }))
+
+	t.Run("single element", func(t *testing.T) {
+		one := NonEmptyOf("only")
+		assert.Equal(t, "only", one.Head())
+		assert.Equal(t, "only", one.Last())
+		assert.Equal(t, []string{}, one.Tail())
+		assert.Equal(t, "only", one.Reduce(func(acc, s string) string { return acc + s }))
+	})
+
+	t.Run("Slice returns a copy", func(t *testing.T) {
+		items := s.Slice()
+		items[0] = 99
+		assert.Equal(t, 3, s.Head())
+	})
+}
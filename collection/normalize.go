@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalizer maps a string to the canonical form used for comparison.
+type Normalizer func(s string) string
+
+// FoldCase applies Unicode case folding, so "Straße" and "STRASSE" compare equal.
+func FoldCase(s string) string {
+	// A Caser is stateful, so one is created per call to stay safe for concurrent use.
+	return cases.Fold().String(s)
+}
+
+// TrimSpace removes leading and trailing white space.
+func TrimSpace(s string) string {
+	return strings.TrimSpace(s)
+}
+
+// NFC converts s to Unicode Normalization Form C, so composed and decomposed accents compare equal.
+func NFC(s string) string {
+	return norm.NFC.String(s)
+}
+
+// ChainNormalizers returns a Normalizer applying normalizers from left to right.
+// Example:
+//   - ChainNormalizers(TrimSpace, NFC, FoldCase)(" Café ") returns "café".
+func ChainNormalizers(normalizers ...Normalizer) Normalizer {
+	return func(s string) string {
+		for _, normalize := range normalizers {
+			s = normalize(s)
+		}
+		return s
+	}
+}
+
+// normalizerOf chains the given normalizers, defaulting to FoldCase when none are given.
+func normalizerOf(normalizers []Normalizer) Normalizer {
+	if len(normalizers) == 0 {
+		return FoldCase
+	}
+	return ChainNormalizers(normalizers...)
+}
+
+// DistinctFold returns the first occurrence of each string, comparing normalized forms.
+// Without normalizers, strings are compared with FoldCase.
+func DistinctFold(slice []string, normalizers ...Normalizer) []string {
+	return DistinctBy(slice, normalizerOf(normalizers))
+}
+
+// ContainsFold reports whether slice contains a string whose normalized form equals the normalized target.
+// Without normalizers, strings are compared with FoldCase.
+func ContainsFold(slice []string, target string, normalizers ...Normalizer) bool {
+	normalize := normalizerOf(normalizers)
+	want := normalize(target)
+	for _, item := range slice {
+		if normalize(item) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupByFold groups strings by their normalized form, keeping the original strings in order.
+// Without normalizers, strings are grouped with FoldCase.
+func GroupByFold(slice []string, normalizers ...Normalizer) map[string][]string {
+	normalize := normalizerOf(normalizers)
+	groups := make(map[string][]string)
+	for _, item := range slice {
+		key := normalize(item)
+		groups[key] = append(groups[key], item)
+	}
+	return groups
+}
+
+// NormalizedSet is a set of strings that treats strings with the same normalized form as equal.
+// It keeps the first original spelling added for each normalized form.
+type NormalizedSet struct {
+	normalize Normalizer
+	items     map[string]string
+	order     []string
+}
+
+// NewNormalizedSet creates a set using the chained normalizers, defaulting to FoldCase.
+func NewNormalizedSet(normalizers ...Normalizer) *NormalizedSet {
+	return &NormalizedSet{
+		normalize: normalizerOf(normalizers),
+		items:     make(map[string]string),
+	}
+}
+
+// Add inserts value and reports whether it was not already present.
+func (s *NormalizedSet) Add(value string) bool {
+	key := s.normalize(value)
+	if _, ok := s.items[key]; ok {
+		return false
+	}
+	s.items[key] = value
+	s.order = append(s.order, key)
+	return true
+}
+
+// Contains reports whether a string with the same normalized form is in the set.
+func (s *NormalizedSet) Contains(value string) bool {
+	_, ok := s.items[s.normalize(value)]
+	return ok
+}
+
+// Remove deletes the string with the same normalized form and reports whether it was present.
+func (s *NormalizedSet) Remove(value string) bool {
+	key := s.normalize(value)
+	if _, ok := s.items[key]; !ok {
+		return false
+	}
+	delete(s.items, key)
+	s.order = Filter(s.order, func(k string) bool { return k != key })
+	return true
+}
+
+// Len returns the number of strings in the set.
+func (s *NormalizedSet) Len() int {
+	return len(s.items)
+}
+
+// Values returns the original spellings in insertion order.
+func (s *NormalizedSet) Values() []string {
+	return Map(s.order, func(key string) string { return s.items[key] })
+}
@@ -0,0 +1,69 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizers(t *testing.T) {
+	t.Run("FoldCase", func(t *testing.T) {
+		assert.Equal(t, FoldCase("STRASSE"), FoldCase("Straße"))
+	})
+
+	t.Run("NFC", func(t *testing.T) {
+		assert.Equal(t, "caf\u00e9", NFC("cafe\u0301"))
+	})
+
+	t.Run("ChainNormalizers", func(t *testing.T) {
+		normalize := ChainNormalizers(TrimSpace, NFC, FoldCase)
+		assert.Equal(t, "caf\u00e9", normalize("  CAFE\u0301 "))
+	})
+}
+
+func TestDistinctFold(t *testing.T) {
+	t.Run("default folds case", func(t *testing.T) {
+		assert.Equal(t, []string{"Go", "Rust"}, DistinctFold([]string{"Go", "GO", "Rust", "go"}))
+	})
+
+	t.Run("custom normalizers", func(t *testing.T) {
+		result := DistinctFold([]string{" caf\u00e9", "cafe\u0301 ", "tea"}, TrimSpace, NFC)
+		assert.Equal(t, []string{" caf\u00e9", "tea"}, result)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		assert.Equal(t, []string{}, DistinctFold([]string{}))
+	})
+}
+
+func TestContainsFold(t *testing.T) {
+	assert.True(t, ContainsFold([]string{"Apple", "Banana"}, "banana"))
+	assert.False(t, ContainsFold([]string{"Apple", "Banana"}, " banana"))
+	assert.True(t, ContainsFold([]string{"Apple", "Banana"}, " banana", TrimSpace, FoldCase))
+	assert.False(t, ContainsFold(nil, "x"))
+}
+
+func TestGroupByFold(t *testing.T) {
+	result := GroupByFold([]string{"Go", "rust", "GO", "Rust ", "go"}, TrimSpace, FoldCase)
+	assert.Equal(t, map[string][]string{
+		"go":   {"Go", "GO", "go"},
+		"rust": {"rust", "Rust "},
+	}, result)
+}
+
+func TestNormalizedSet(t *testing.T) {
+	set := NewNormalizedSet(TrimSpace, FoldCase)
+
+	assert.True(t, set.Add("Alice"))
+	assert.False(t, set.Add(" alice "))
+	assert.True(t, set.Add("Bob"))
+	assert.True(t, set.Add("Carol"))
+	assert.Equal(t, 3, set.Len())
+
+	assert.True(t, set.Contains("BOB"))
+	assert.False(t, set.Contains("Dave"))
+
+	assert.True(t, set.Remove("bob"))
+	assert.False(t, set.Remove("bob"))
+	assert.Equal(t, []string{"Alice", "Carol"}, set.Values())
+}
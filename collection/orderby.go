@@ -0,0 +1,73 @@
+package collection
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SortKey is a three-way comparator used as one level of an Ordering.
+type SortKey[T any] func(a, b T) int
+
+// By builds a SortKey comparing the ordered key extracted by getter.
+func By[T any, K constraints.Ordered](getter func(T) K) SortKey[T] {
+	return func(a, b T) int {
+		ka, kb := getter(a), getter(b)
+		switch {
+		case ka < kb:
+			return -1
+		case ka > kb:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// Ordering is a multi-level sort built with OrderBy, ThenBy and Desc.
+// Each method returns a new Ordering, so partial orderings can be shared.
+type Ordering[T any] struct {
+	keys []SortKey[T]
+}
+
+// OrderBy starts an Ordering with key as the primary sort level.
+// Example:
+//   - OrderBy(By(func(o Order) string { return o.CustomerCode })).
+//     ThenBy(By(func(o Order) float64 { return o.Amount })).Desc().
+//     Sort(orders)
+func OrderBy[T any](key SortKey[T]) Ordering[T] {
+	return Ordering[T]{keys: []SortKey[T]{key}}
+}
+
+// ThenBy adds key as the next sort level, used when all previous levels compare equal.
+func (o Ordering[T]) ThenBy(key SortKey[T]) Ordering[T] {
+	keys := make([]SortKey[T], len(o.keys), len(o.keys)+1)
+	copy(keys, o.keys)
+	return Ordering[T]{keys: append(keys, key)}
+}
+
+// Desc reverses the direction of the most recently added sort level.
+func (o Ordering[T]) Desc() Ordering[T] {
+	keys := CloneList(o.keys)
+	last := keys[len(keys)-1]
+	keys[len(keys)-1] = func(a, b T) int { return last(b, a) }
+	return Ordering[T]{keys: keys}
+}
+
+// Compare compares a and b level by level and returns the first non-zero result.
+func (o Ordering[T]) Compare(a, b T) int {
+	for _, key := range o.keys {
+		if c := key(a, b); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// Sort sorts list stably by the ordering, in place, and returns it.
+func (o Ordering[T]) Sort(list []T) []T {
+	sort.SliceStable(list, func(i, j int) bool {
+		return o.Compare(list[i], list[j]) < 0
+	})
+	return list
+}
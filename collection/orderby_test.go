@@ -0,0 +1,69 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderBy(t *testing.T) {
+	type SalesOrder struct {
+		CustomerCode     string
+		SalesOrderNumber string
+		Amount           float64
+	}
+	customer := By(func(o SalesOrder) string { return o.CustomerCode })
+	number := By(func(o SalesOrder) string { return o.SalesOrderNumber })
+	amount := By(func(o SalesOrder) float64 { return o.Amount })
+
+	source := func() []SalesOrder {
+		return []SalesOrder{
+			{"C2", "S2", 200},
+			{"C1", "S3", 300},
+			{"C2", "S4", 400},
+			{"C1", "S1", 100},
+		}
+	}
+
+	t.Run("two ascending levels", func(t *testing.T) {
+		sorted := OrderBy(customer).ThenBy(number).Sort(source())
+		expected := []SalesOrder{
+			{"C1", "S1", 100},
+			{"C1", "S3", 300},
+			{"C2", "S2", 200},
+			{"C2", "S4", 400},
+		}
+		assert.Equal(t, expected, sorted)
+	})
+
+	t.Run("descending second level", func(t *testing.T) {
+		sorted := OrderBy(customer).ThenBy(amount).Desc().Sort(source())
+		expected := []SalesOrder{
+			{"C1", "S3", 300},
+			{"C1", "S1", 100},
+			{"C2", "S4", 400},
+			{"C2", "S2", 200},
+		}
+		assert.Equal(t, expected, sorted)
+	})
+
+	t.Run("descending first level", func(t *testing.T) {
+		sorted := OrderBy(customer).Desc().ThenBy(number).Sort(source())
+		assert.Equal(t, []string{"S2", "S4", "S1", "S3"}, Map(sorted, func(o SalesOrder) string { return o.SalesOrderNumber }))
+	})
+
+	t.Run("stable for equal keys", func(t *testing.T) {
+		sorted := OrderBy(customer).Sort(source())
+		assert.Equal(t, []string{"S3", "S1", "S2", "S4"}, Map(sorted, func(o SalesOrder) string { return o.SalesOrderNumber }))
+	})
+
+	t.Run("orderings are immutable", func(t *testing.T) {
+		base := OrderBy(customer)
+		byAmountDesc := base.ThenBy(amount).Desc()
+		byNumber := base.ThenBy(number)
+
+		assert.Equal(t, 0, base.Compare(SalesOrder{"C1", "S1", 1}, SalesOrder{"C1", "S2", 2}))
+		assert.Equal(t, 1, byAmountDesc.Compare(SalesOrder{"C1", "S1", 1}, SalesOrder{"C1", "S2", 2}))
+		assert.Equal(t, -1, byNumber.Compare(SalesOrder{"C1", "S1", 1}, SalesOrder{"C1", "S2", 2}))
+	})
+}
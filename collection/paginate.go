@@ -0,0 +1,52 @@
+package collection
+
+// PageInfo describes the position of a page within a paginated slice.
+type PageInfo struct {
+	Page       int
+	PageSize   int
+	TotalItems int
+	TotalPages int
+	HasNext    bool
+	HasPrev    bool
+}
+
+// Paginate returns the items on the given 1-based page and the matching PageInfo.
+// A page below 1 is treated as 1, and a pageSize below 1 puts every item on a single page.
+// Pages past the end return an empty slice. The items are a copy, so changing them leaves slice intact.
+// Example:
+//   - Paginate([]int{1, 2, 3, 4, 5}, 2, 2) returns [3 4] with TotalPages 3, HasNext and HasPrev.
+func Paginate[T any](slice []T, page, pageSize int) (items []T, info PageInfo) {
+	total := len(slice)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = total
+	}
+
+	info = PageInfo{
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+	}
+	if pageSize > 0 {
+		// Divide before rounding up so a pageSize near math.MaxInt cannot overflow.
+		info.TotalPages = total / pageSize
+		if total%pageSize != 0 {
+			info.TotalPages++
+		}
+	}
+	info.HasNext = page < info.TotalPages
+	info.HasPrev = page > 1
+
+	// Checking the page count first keeps (page-1)*pageSize below total, so it cannot overflow.
+	if page > info.TotalPages {
+		return []T{}, info
+	}
+	start := (page - 1) * pageSize
+	end := total
+	if pageSize < total-start {
+		end = start + pageSize
+	}
+	return CloneList(slice[start:end]), info
+}
@@ -0,0 +1,72 @@
+package collection
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name     string
+		page     int
+		pageSize int
+		items    []int
+		info     PageInfo
+	}{
+		{"first page", 1, 2, []int{1, 2}, PageInfo{Page: 1, PageSize: 2, TotalItems: 5, TotalPages: 3, HasNext: true}},
+		{"middle page", 2, 2, []int{3, 4}, PageInfo{Page: 2, PageSize: 2, TotalItems: 5, TotalPages: 3, HasNext: true, HasPrev: true}},
+		{"last partial page", 3, 2, []int{5}, PageInfo{Page: 3, PageSize: 2, TotalItems: 5, TotalPages: 3, HasPrev: true}},
+		{"past the end", 4, 2, []int{}, PageInfo{Page: 4, PageSize: 2, TotalItems: 5, TotalPages: 3, HasPrev: true}},
+		{"page below 1", 0, 2, []int{1, 2}, PageInfo{Page: 1, PageSize: 2, TotalItems: 5, TotalPages: 3, HasNext: true}},
+		{"page size below 1", 1, 0, []int{1, 2, 3, 4, 5}, PageInfo{Page: 1, PageSize: 5, TotalItems: 5, TotalPages: 1}},
+		{"exact fit", 1, 5, []int{1, 2, 3, 4, 5}, PageInfo{Page: 1, PageSize: 5, TotalItems: 5, TotalPages: 1}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			items, info := Paginate(source, tc.page, tc.pageSize)
+			assert.Equal(t, tc.items, items)
+			assert.Equal(t, tc.info, info)
+		})
+	}
+
+	t.Run("empty slice", func(t *testing.T) {
+		items, info := Paginate([]int{}, 1, 10)
+		assert.Equal(t, []int{}, items)
+		assert.Equal(t, PageInfo{Page: 1, PageSize: 10}, info)
+	})
+
+	t.Run("huge page does not overflow", func(t *testing.T) {
+		for _, page := range []int{math.MaxInt / 2, math.MaxInt} {
+			items, info := Paginate(source, page, 2)
+			assert.Equal(t, []int{}, items)
+			assert.Equal(t, PageInfo{Page: page, PageSize: 2, TotalItems: 5, TotalPages: 3, HasPrev: true}, info)
+		}
+	})
+
+	t.Run("huge page size does not overflow", func(t *testing.T) {
+		items, info := Paginate(source, 1, math.MaxInt)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+		assert.Equal(t, PageInfo{Page: 1, PageSize: math.MaxInt, TotalItems: 5, TotalPages: 1}, info)
+
+		items, info = Paginate(source, math.MaxInt, math.MaxInt)
+		assert.Equal(t, []int{}, items)
+		assert.Equal(t, PageInfo{Page: math.MaxInt, PageSize: math.MaxInt, TotalItems: 5, TotalPages: 1, HasPrev: true}, info)
+	})
+
+	t.Run("appending to a page does not overwrite the source", func(t *testing.T) {
+		items, _ := Paginate(source, 1, 2)
+		_ = append(items, 99)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, source)
+	})
+
+	t.Run("writing into a page does not modify the source", func(t *testing.T) {
+		items, _ := Paginate(source, 2, 2)
+		items[0] = 99
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, source)
+	})
+}
@@ -0,0 +1,20 @@
+package collection
+
+// Reverse returns a new slice holding the elements of source in reverse order.
+// Example:
+//   - Take(Reverse(Sort(scores, less)), 3) returns the top three scores.
+func Reverse[T any](source []T) []T {
+	result := make([]T, len(source))
+	for i, item := range source {
+		result[len(source)-1-i] = item
+	}
+	return result
+}
+
+// ReverseInPlace reverses list without allocating and returns it, like Sort.
+func ReverseInPlace[T any](list []T) []T {
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+	return list
+}
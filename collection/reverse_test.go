@@ -0,0 +1,30 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	source := []int{1, 2, 3}
+	assert.Equal(t, []int{3, 2, 1}, Reverse(source))
+	assert.Equal(t, []int{1, 2, 3}, source)
+	assert.Equal(t, []int{}, Reverse([]int{}))
+
+	scores := CloneList([]int{4, 9, 1, 7})
+	Sort(scores, func(i, j int) bool { return scores[i] < scores[j] })
+	assert.Equal(t, []int{9, 7}, Take(Reverse(scores), 2))
+}
+
+func TestReverseInPlace(t *testing.T) {
+	even := []int{1, 2, 3, 4}
+	ReverseInPlace(even)
+	assert.Equal(t, []int{4, 3, 2, 1}, even)
+
+	odd := []string{"a", "b", "c"}
+	assert.Equal(t, []string{"c", "b", "a"}, ReverseInPlace(odd))
+	assert.Equal(t, []string{"c", "b", "a"}, odd)
+
+	assert.Empty(t, ReverseInPlace([]int(nil)))
+}
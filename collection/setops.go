@@ -0,0 +1,30 @@
+package collection
+
+import (
+	set "github.com/lumiluminousai/golang-fp-utility/set"
+)
+
+// Union returns the distinct elements of every slice, in order of first occurrence.
+// Example:
+//   - Union([]int{1, 2, 2}, []int{3, 1}) returns [1 2 3].
+func Union[T comparable](slices ...[]T) []T {
+	return Distinct(Concat(slices...))
+}
+
+// Intersection returns the distinct elements of first that also occur in second,
+// in order of first occurrence in first.
+// Example:
+//   - Intersection([]int{3, 1, 2, 1}, []int{1, 3}) returns [3 1].
+func Intersection[T comparable](first, second []T) []T {
+	other := set.FromSlice(second)
+	return Distinct(Filter(first, other.Contains))
+}
+
+// Difference returns the distinct elements of first that do not occur in second,
+// in order of first occurrence in first.
+// Example:
+//   - Difference(localIDs, remoteIDs) returns the IDs missing remotely.
+func Difference[T comparable](first, second []T) []T {
+	other := set.FromSlice(second)
+	return Distinct(Filter(first, func(item T) bool { return !other.Contains(item) }))
+}
@@ -0,0 +1,27 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnion(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, Union([]int{1, 2, 2}, []int{3, 1}))
+	assert.Equal(t, []string{"b", "a", "c"}, Union([]string{"b"}, []string{"a", "b"}, []string{"c"}))
+	assert.Equal(t, []int{}, Union[int]())
+}
+
+func TestIntersection(t *testing.T) {
+	assert.Equal(t, []int{3, 1}, Intersection([]int{3, 1, 2, 1}, []int{1, 3}))
+	assert.Equal(t, []int{}, Intersection([]int{1, 2}, []int{}))
+	assert.Equal(t, []int{}, Intersection([]int{}, []int{1}))
+}
+
+func TestDifference(t *testing.T) {
+	local := []string{"id-3", "id-1", "id-2", "id-3"}
+	remote := []string{"id-1"}
+	assert.Equal(t, []string{"id-3", "id-2"}, Difference(local, remote))
+	assert.Equal(t, []string{}, Difference(remote, local))
+	assert.Equal(t, []int{1, 2}, Difference([]int{1, 2}, nil))
+}
@@ -0,0 +1,65 @@
+package collection
+
+// clampCount limits n to the range [0, length].
+func clampCount(n, length int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > length {
+		return length
+	}
+	return n
+}
+
+// Take returns the first n elements of source. A negative n yields none and an n above
+// len(source) yields all of them.
+// Example:
+//   - Take([]int{1, 2, 3}, 2) returns [1 2].
+func Take[T any](source []T, n int) []T {
+	return CloneList(source[:clampCount(n, len(source))])
+}
+
+// Drop returns source without its first n elements. A negative n drops none and an n above
+// len(source) drops all of them.
+// Example:
+//   - Drop([]int{1, 2, 3}, 2) returns [3].
+func Drop[T any](source []T, n int) []T {
+	return CloneList(source[clampCount(n, len(source)):])
+}
+
+// TakeLast returns the last n elements of source, bounded like Take.
+// Example:
+//   - TakeLast([]int{1, 2, 3}, 2) returns [2 3].
+func TakeLast[T any](source []T, n int) []T {
+	return Drop(source, len(source)-clampCount(n, len(source)))
+}
+
+// DropLast returns source without its last n elements, bounded like Drop.
+// Example:
+//   - DropLast([]int{1, 2, 3}, 2) returns [1].
+func DropLast[T any](source []T, n int) []T {
+	return Take(source, len(source)-clampCount(n, len(source)))
+}
+
+// TakeWhile returns the longest prefix of source whose elements all satisfy the predicate.
+// Unlike Filter it stops at the first element that fails.
+// Example:
+//   - TakeWhile([]int{1, 2, 5, 1}, lessThan3) returns [1 2].
+func TakeWhile[T any](source []T, predicate func(item T) bool) []T {
+	end := 0
+	for end < len(source) && predicate(source[end]) {
+		end++
+	}
+	return CloneList(source[:end])
+}
+
+// DropWhile returns source without its longest prefix of elements satisfying the predicate.
+// Example:
+//   - DropWhile([]int{1, 2, 5, 1}, lessThan3) returns [5 1].
+func DropWhile[T any](source []T, predicate func(item T) bool) []T {
+	start := 0
+	for start < len(source) && predicate(source[start]) {
+		start++
+	}
+	return CloneList(source[start:])
+}
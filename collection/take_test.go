@@ -0,0 +1,83 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTakeAndDrop(t *testing.T) {
+	source := []int{1, 2, 3}
+
+	t.Run("Take", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, Take(source, 2))
+		assert.Equal(t, []int{1, 2, 3}, Take(source, 5))
+		assert.Equal(t, []int{}, Take(source, -1))
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		assert.Equal(t, []int{3}, Drop(source, 2))
+		assert.Equal(t, []int{}, Drop(source, 5))
+		assert.Equal(t, []int{1, 2, 3}, Drop(source, -1))
+	})
+
+	t.Run("TakeLast", func(t *testing.T) {
+		assert.Equal(t, []int{2, 3}, TakeLast(source, 2))
+		assert.Equal(t, []int{1, 2, 3}, TakeLast(source, 5))
+		assert.Equal(t, []int{}, TakeLast(source, -1))
+	})
+
+	t.Run("DropLast", func(t *testing.T) {
+		assert.Equal(t, []int{1}, DropLast(source, 2))
+		assert.Equal(t, []int{}, DropLast(source, 5))
+		assert.Equal(t, []int{1, 2, 3}, DropLast(source, -1))
+	})
+
+	t.Run("empty source", func(t *testing.T) {
+		assert.Empty(t, Take([]int{}, 1))
+		assert.Empty(t, TakeLast([]int(nil), 1))
+	})
+
+	t.Run("appending to the result does not overwrite the source", func(t *testing.T) {
+		_ = append(Take(source, 1), 99)
+		assert.Equal(t, []int{1, 2, 3}, source)
+	})
+
+	t.Run("writing into the result does not modify the source", func(t *testing.T) {
+		for _, result := range [][]int{Take(source, 2), Drop(source, 1), TakeLast(source, 2), DropLast(source, 1)} {
+			result[0] = 99
+		}
+		assert.Equal(t, []int{1, 2, 3}, source)
+	})
+}
+
+func TestTakeWhile(t *testing.T) {
+	lessThan3 := func(n int) bool { return n < 3 }
+
+	assert.Equal(t, []int{1, 2}, TakeWhile([]int{1, 2, 5, 1}, lessThan3))
+	assert.Equal(t, []int{1, 2}, TakeWhile([]int{1, 2}, lessThan3))
+	assert.Equal(t, []int{}, TakeWhile([]int{5, 1}, lessThan3))
+	assert.Empty(t, TakeWhile([]int{}, lessThan3))
+
+	t.Run("appending to the result does not overwrite the source", func(t *testing.T) {
+		source := []int{1, 5}
+		_ = append(TakeWhile(source, lessThan3), 99)
+		assert.Equal(t, []int{1, 5}, source)
+	})
+
+	t.Run("writing into the result does not modify the source", func(t *testing.T) {
+		source := []int{1, 5}
+		TakeWhile(source, lessThan3)[0] = 99
+		DropWhile(source, lessThan3)[0] = 99
+		assert.Equal(t, []int{1, 5}, source)
+	})
+}
+
+func TestDropWhile(t *testing.T) {
+	lessThan3 := func(n int) bool { return n < 3 }
+
+	assert.Equal(t, []int{5, 1}, DropWhile([]int{1, 2, 5, 1}, lessThan3))
+	assert.Equal(t, []int{5, 1}, DropWhile([]int{5, 1}, lessThan3))
+	assert.Equal(t, []int{}, DropWhile([]int{1, 2}, lessThan3))
+	assert.Empty(t, DropWhile([]int{}, lessThan3))
+}
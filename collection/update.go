@@ -0,0 +1,36 @@
+package collection
+
+// UpdateWhere returns a copy of source where the elements satisfying the predicate are replaced
+// by update(element), together with the number of updated elements.
+// Example:
+//   - UpdateWhere(orders, isPending, markShipped) returns the new orders and how many were shipped.
+func UpdateWhere[T any](source []T, predicate func(item T) bool, update func(item T) T) ([]T, int) {
+	result := make([]T, len(source))
+	updated := 0
+	for i, item := range source {
+		if predicate(item) {
+			result[i] = update(item)
+			updated++
+		} else {
+			result[i] = item
+		}
+	}
+	return result, updated
+}
+
+// UpsertBy returns a copy of source where the first element with the same key as item is replaced
+// by merge(existing, item), or with item appended when no element has that key.
+// Example:
+//   - UpsertBy(users, userID, incoming, func(old, new User) User { new.CreatedAt = old.CreatedAt; return new })
+func UpsertBy[T any, K comparable](source []T, keyFn func(item T) K, item T, merge func(existing, item T) T) []T {
+	key := keyFn(item)
+	result := make([]T, len(source), len(source)+1)
+	copy(result, source)
+	for i, existing := range result {
+		if keyFn(existing) == key {
+			result[i] = merge(existing, item)
+			return result
+		}
+	}
+	return append(result, item)
+}
@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type account struct {
+	ID      int
+	Balance int
+	Frozen  bool
+}
+
+func TestUpdateWhere(t *testing.T) {
+	t.Run("updates matching elements on a copy", func(t *testing.T) {
+		source := []account{{1, 100, false}, {2, -5, false}, {3, -20, false}}
+		result, updated := UpdateWhere(source, func(a account) bool { return a.Balance < 0 }, func(a account) account {
+			a.Frozen = true
+			return a
+		})
+		assert.Equal(t, 2, updated)
+		assert.Equal(t, []account{{1, 100, false}, {2, -5, true}, {3, -20, true}}, result)
+		assert.Equal(t, []account{{1, 100, false}, {2, -5, false}, {3, -20, false}}, source)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		result, updated := UpdateWhere([]int{1, 2}, func(n int) bool { return n > 5 }, func(n int) int { return 0 })
+		assert.Equal(t, 0, updated)
+		assert.Equal(t, []int{1, 2}, result)
+	})
+}
+
+func TestUpsertBy(t *testing.T) {
+	id := func(a account) int { return a.ID }
+	addBalance := func(existing, item account) account {
+		existing.Balance += item.Balance
+		return existing
+	}
+
+	t.Run("updates existing key", func(t *testing.T) {
+		source := []account{{1, 100, false}, {2, 50, true}}
+		result := UpsertBy(source, id, account{2, 25, false}, addBalance)
+		assert.Equal(t, []account{{1, 100, false}, {2, 75, true}}, result)
+		assert.Equal(t, []account{{1, 100, false}, {2, 50, true}}, source)
+	})
+
+	t.Run("inserts missing key", func(t *testing.T) {
+		source := make([]account, 1, 10)
+		source[0] = account{1, 100, false}
+		result := UpsertBy(source, id, account{3, 10, false}, addBalance)
+		assert.Equal(t, []account{{1, 100, false}, {3, 10, false}}, result)
+
+		// The source's spare capacity must not be shared with the result.
+		_ = append(source, account{9, 0, false})
+		assert.Equal(t, 3, result[1].ID)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		result := UpsertBy(nil, id, account{1, 1, false}, addBalance)
+		assert.Equal(t, []account{{1, 1, false}}, result)
+	})
+}
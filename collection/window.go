@@ -0,0 +1,59 @@
+package collection
+
+// SlidingWindow returns runs of size consecutive elements, starting a new window every step elements.
+// A step of 1 yields every overlapping window, a step equal to size yields adjacent chunks, and a
+// trailing window shorter than size is dropped. Every window is a separate copy; a size or step
+// below 1, or a size above len(source), yields none.
+// Example:
+//   - SlidingWindow([]int{1, 2, 3, 4}, 3, 1) returns [[1 2 3] [2 3 4]].
+//   - SlidingWindow([]int{1, 2, 3, 4, 5}, 2, 2) returns [[1 2] [3 4]].
+func SlidingWindow[T any](source []T, size, step int) [][]T {
+	if size < 1 || step < 1 || size > len(source) {
+		return [][]T{}
+	}
+	windows := make([][]T, 0, (len(source)-size)/step+1)
+	for i := 0; i+size <= len(source); i += step {
+		windows = append(windows, CloneList(source[i:i+size]))
+	}
+	return windows
+}
+
+// Scan is like Fold but returns every intermediate accumulator, one per element,
+// such as prefix sums or running balances. The initial value itself is not included.
+// Example:
+//   - Scan([]int{1, 2, 3}, add, 0) returns [1 3 6].
+func Scan[T any, R any](source []T, scanFunc func(acc R, item T) R, initialValue R) []R {
+	result := make([]R, len(source))
+	acc := initialValue
+	for i, item := range source {
+		acc = scanFunc(acc, item)
+		result[i] = acc
+	}
+	return result
+}
+
+// MovingSum returns the sum of each sliding window of the given size.
+// Result i covers values[i : i+window], so the result is aligned with SlidingWindow.
+func MovingSum[T Summable](values []T, window int) []T {
+	return Map(SlidingWindow(values, window, 1), Sum[T])
+}
+
+// MovingAverage returns the mean of each sliding window of the given size.
+// Result i covers values[i : i+window], so the result is aligned with SlidingWindow.
+func MovingAverage[T Summable](values []T, window int) []float64 {
+	return Map(MovingSum(values, window), func(sum T) float64 {
+		return float64(sum) / float64(window)
+	})
+}
+
+// EMA returns the exponential moving average of values with smoothing factor alpha in (0, 1].
+// The result has one entry per value and starts at values[0].
+func EMA[T Summable](values []T, alpha float64) []float64 {
+	if len(values) == 0 {
+		return []float64{}
+	}
+	first := float64(values[0])
+	return Scan(values, func(previous float64, value T) float64 {
+		return alpha*float64(value) + (1-alpha)*previous
+	}, first)
+}
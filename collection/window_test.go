@@ -0,0 +1,76 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlidingWindow(t *testing.T) {
+	t.Run("windows", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}}, SlidingWindow([]int{1, 2, 3, 4}, 3, 1))
+		assert.Equal(t, [][]int{{1}, {2}}, SlidingWindow([]int{1, 2}, 1, 1))
+	})
+
+	t.Run("step", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}}, SlidingWindow([]int{1, 2, 3, 4, 5}, 2, 2))
+		assert.Equal(t, [][]int{{1, 2, 3}, {3, 4, 5}}, SlidingWindow([]int{1, 2, 3, 4, 5}, 3, 2))
+		assert.Equal(t, [][]int{{1}, {4}}, SlidingWindow([]int{1, 2, 3, 4, 5}, 1, 3))
+	})
+
+	t.Run("size or step out of range", func(t *testing.T) {
+		assert.Equal(t, [][]int{}, SlidingWindow([]int{1, 2}, 3, 1))
+		assert.Equal(t, [][]int{}, SlidingWindow([]int{1, 2}, 0, 1))
+		assert.Equal(t, [][]int{}, SlidingWindow([]int{1, 2}, 1, 0))
+	})
+
+	t.Run("appending to a window does not overwrite the source", func(t *testing.T) {
+		source := []int{1, 2, 3}
+		windows := SlidingWindow(source, 2, 1)
+		_ = append(windows[0], 99)
+		assert.Equal(t, []int{1, 2, 3}, source)
+	})
+
+	t.Run("writing into a window leaves its neighbours and the source intact", func(t *testing.T) {
+		source := []int{1, 2, 3}
+		windows := SlidingWindow(source, 2, 1)
+		windows[0][1] = 99
+		assert.Equal(t, [][]int{{1, 99}, {2, 3}}, windows)
+		assert.Equal(t, []int{1, 2, 3}, source)
+	})
+}
+
+func TestScan(t *testing.T) {
+	add := func(acc, n int) int { return acc + n }
+	assert.Equal(t, []int{1, 3, 6}, Scan([]int{1, 2, 3}, add, 0))
+	assert.Equal(t, []int{}, Scan([]int{}, add, 0))
+
+	joined := Scan([]string{"a", "bb", "ccc"}, func(acc string, s string) string { return acc + s }, "")
+	assert.Equal(t, []string{"a", "abb", "abbccc"}, joined)
+
+	t.Run("running balance", func(t *testing.T) {
+		balances := Scan([]float64{-20, 50, -5}, func(balance, movement float64) float64 { return balance + movement }, 100.0)
+		assert.Equal(t, []float64{80, 130, 125}, balances)
+		assert.Equal(t, Fold([]float64{-20, 50, -5}, func(b, m float64) float64 { return b + m }, 100.0), balances[2])
+	})
+}
+
+func TestMovingAggregates(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+
+	t.Run("MovingSum", func(t *testing.T) {
+		assert.Equal(t, []int{6, 9, 12}, MovingSum(values, 3))
+	})
+
+	t.Run("MovingAverage", func(t *testing.T) {
+		assert.Equal(t, []float64{1.5, 2.5, 3.5, 4.5}, MovingAverage(values, 2))
+		assert.Equal(t, []float64{}, MovingAverage(values, 6))
+	})
+
+	t.Run("EMA", func(t *testing.T) {
+		result := EMA([]float64{10, 20, 30}, 0.5)
+		assert.Equal(t, []float64{10, 15, 22.5}, result)
+		assert.Equal(t, []float64{1, 2, 3}, EMA([]int{1, 2, 3}, 1))
+		assert.Equal(t, []float64{}, EMA([]int{}, 0.5))
+	})
+}
@@ -0,0 +1,36 @@
+package collection
+
+import (
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+// Zip pairs up the elements of two slices by index. The result is as long as the shorter slice.
+func Zip[A any, B any](first []A, second []B) []tuple.Pair[A, B] {
+	return ZipWith(first, second, tuple.NewPair[A, B])
+}
+
+// ZipWith combines the elements of two slices by index with combine.
+// The result is as long as the shorter slice.
+// Example:
+//   - ZipWith(prices, quantities, func(p float64, q int) float64 { return p * float64(q) })
+func ZipWith[A any, B any, C any](first []A, second []B, combine func(a A, b B) C) []C {
+	length := len(first)
+	if len(second) < length {
+		length = len(second)
+	}
+	result := make([]C, length)
+	for i := 0; i < length; i++ {
+		result[i] = combine(first[i], second[i])
+	}
+	return result
+}
+
+// Unzip splits a slice of pairs into two slices.
+func Unzip[A any, B any](pairs []tuple.Pair[A, B]) ([]A, []B) {
+	first := make([]A, len(pairs))
+	second := make([]B, len(pairs))
+	for i, pair := range pairs {
+		first[i], second[i] = pair.First, pair.Second
+	}
+	return first, second
+}
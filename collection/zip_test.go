@@ -0,0 +1,38 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+func TestZip(t *testing.T) {
+	t.Run("equal lengths", func(t *testing.T) {
+		result := Zip([]string{"a", "b"}, []int{1, 2})
+		assert.Equal(t, []tuple.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}}, result)
+	})
+
+	t.Run("truncates to the shorter slice", func(t *testing.T) {
+		assert.Len(t, Zip([]string{"a", "b", "c"}, []int{1}), 1)
+		assert.Equal(t, []tuple.Pair[string, int]{}, Zip([]string{}, []int{1, 2}))
+	})
+}
+
+func TestZipWith(t *testing.T) {
+	prices := []float64{1.5, 2, 10}
+	quantities := []int{2, 3}
+	totals := ZipWith(prices, quantities, func(p float64, q int) float64 { return p * float64(q) })
+	assert.Equal(t, []float64{3, 6}, totals)
+}
+
+func TestUnzip(t *testing.T) {
+	names, ages := Unzip([]tuple.Pair[string, int]{{First: "ann", Second: 30}, {First: "bob", Second: 25}})
+	assert.Equal(t, []string{"ann", "bob"}, names)
+	assert.Equal(t, []int{30, 25}, ages)
+
+	first, second := Unzip(Zip([]int{1, 2}, []bool{true, false}))
+	assert.Equal(t, []int{1, 2}, first)
+	assert.Equal(t, []bool{true, false}, second)
+}
@@ -0,0 +1,113 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	future "github.com/lumiluminousai/golang-fp-utility/future"
+)
+
+// ErrBatcherClosed is returned for items submitted after Close.
+var ErrBatcherClosed = errors.New("batcher: closed")
+
+// BatcherOptions configures a Batcher.
+type BatcherOptions struct {
+	MaxSize    int           // flush when this many items are pending, defaults to 100
+	MaxLatency time.Duration // flush at the latest this long after the first pending item, defaults to 10ms
+}
+
+// Batcher groups individually submitted items into batches and resolves each caller's Future
+// with its own result.
+type Batcher[T any, R any] struct {
+	ctx     context.Context
+	batchFn func(ctx context.Context, items []T) ([]R, error)
+	opts    BatcherOptions
+
+	mu         sync.Mutex
+	items      []T
+	completers []func(R, error)
+	generation int
+	closed     bool
+	running    sync.WaitGroup
+}
+
+// NewBatcher creates a Batcher calling batchFn with ctx for every batch.
+// batchFn must return exactly one result per item, in the same order.
+func NewBatcher[T any, R any](ctx context.Context, batchFn func(ctx context.Context, items []T) ([]R, error), opts BatcherOptions) *Batcher[T, R] {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = 100
+	}
+	if opts.MaxLatency <= 0 {
+		opts.MaxLatency = 10 * time.Millisecond
+	}
+	return &Batcher[T, R]{ctx: ctx, batchFn: batchFn, opts: opts}
+}
+
+// Submit adds an item to the current batch and returns a Future for its result.
+func (b *Batcher[T, R]) Submit(item T) future.Future[R] {
+	result, complete := future.New[R]()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		var zero R
+		complete(zero, ErrBatcherClosed)
+		return result
+	}
+
+	b.items = append(b.items, item)
+	b.completers = append(b.completers, complete)
+	if len(b.items) == 1 {
+		generation := b.generation
+		time.AfterFunc(b.opts.MaxLatency, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if b.generation == generation {
+				b.dispatch()
+			}
+		})
+	}
+	if len(b.items) >= b.opts.MaxSize {
+		b.dispatch()
+	}
+	return result
+}
+
+// Close flushes the pending items, waits for all running batches and rejects further submissions.
+func (b *Batcher[T, R]) Close() {
+	b.mu.Lock()
+	b.closed = true
+	b.dispatch()
+	b.mu.Unlock()
+	b.running.Wait()
+}
+
+// dispatch starts the pending batch in the background. b.mu must be held.
+func (b *Batcher[T, R]) dispatch() {
+	if len(b.items) == 0 {
+		return
+	}
+	items, completers := b.items, b.completers
+	b.items, b.completers = nil, nil
+	b.generation++
+
+	b.running.Add(1)
+	go func() {
+		defer b.running.Done()
+		results, err := b.batchFn(b.ctx, items)
+		if err == nil && len(results) != len(items) {
+			err = fmt.Errorf("batcher: batch function returned %d results for %d items", len(results), len(items))
+		}
+		for idx, complete := range completers {
+			if err != nil {
+				var zero R
+				complete(zero, err)
+				continue
+			}
+			complete(results[idx], nil)
+		}
+	}()
+}
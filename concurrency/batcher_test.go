@@ -0,0 +1,105 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	future "github.com/lumiluminousai/golang-fp-utility/future"
+)
+
+func TestBatcher(t *testing.T) {
+	double := func(batches *[][]int, mu *sync.Mutex) func(ctx context.Context, items []int) ([]int, error) {
+		return func(ctx context.Context, items []int) ([]int, error) {
+			mu.Lock()
+			*batches = append(*batches, items)
+			mu.Unlock()
+			results := make([]int, len(items))
+			for i, item := range items {
+				results[i] = item * 2
+			}
+			return results, nil
+		}
+	}
+
+	t.Run("flushes when the batch is full", func(t *testing.T) {
+		var (
+			batches [][]int
+			mu      sync.Mutex
+		)
+		b := NewBatcher(context.Background(), double(&batches, &mu), BatcherOptions{MaxSize: 3, MaxLatency: time.Hour})
+		defer b.Close()
+
+		futures := []future.Future[int]{b.Submit(1), b.Submit(2), b.Submit(3)}
+
+		for i, expected := range []int{2, 4, 6} {
+			value, err := futures[i].Await(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, expected, value)
+		}
+		mu.Lock()
+		assert.Equal(t, [][]int{{1, 2, 3}}, batches)
+		mu.Unlock()
+	})
+
+	t.Run("flushes after max latency", func(t *testing.T) {
+		var (
+			batches [][]int
+			mu      sync.Mutex
+		)
+		b := NewBatcher(context.Background(), double(&batches, &mu), BatcherOptions{MaxSize: 100, MaxLatency: 5 * time.Millisecond})
+		defer b.Close()
+
+		value, err := b.Submit(21).Await(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 42, value)
+	})
+
+	t.Run("batch error fails every item", func(t *testing.T) {
+		b := NewBatcher(context.Background(), func(ctx context.Context, items []string) ([]int, error) {
+			return nil, errors.New("down")
+		}, BatcherOptions{MaxSize: 2})
+		defer b.Close()
+
+		f1, f2 := b.Submit("a"), b.Submit("b")
+		_, err1 := f1.Await(context.Background())
+		_, err2 := f2.Await(context.Background())
+		assert.EqualError(t, err1, "down")
+		assert.EqualError(t, err2, "down")
+	})
+
+	t.Run("result count mismatch", func(t *testing.T) {
+		b := NewBatcher(context.Background(), func(ctx context.Context, items []int) ([]int, error) {
+			return []int{1}, nil
+		}, BatcherOptions{MaxSize: 2})
+		defer b.Close()
+
+		f := b.Submit(1)
+		b.Submit(2)
+		_, err := f.Await(context.Background())
+		assert.EqualError(t, err, "batcher: batch function returned 1 results for 2 items")
+	})
+
+	t.Run("close flushes pending items and rejects new ones", func(t *testing.T) {
+		var (
+			batches [][]int
+			mu      sync.Mutex
+		)
+		b := NewBatcher(context.Background(), double(&batches, &mu), BatcherOptions{MaxSize: 10, MaxLatency: time.Hour})
+
+		pending := b.Submit(5)
+		b.Close()
+
+		value, err := pending.Await(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 10, value)
+
+		_, err = b.Submit(6).Await(context.Background())
+		assert.ErrorIs(t, err, ErrBatcherClosed)
+	})
+}
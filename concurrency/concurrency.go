@@ -0,0 +1,99 @@
+// Package concurrency provides concurrent counterparts of the collection functions.
+package concurrency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ParallelMap applies a transformation function to each item using up to workers goroutines
+// and returns the results in the original order.
+// The first error cancels the context passed to the remaining calls and is returned wrapped with its index.
+func ParallelMap[T1 any, T2 any](ctx context.Context, source []T1, workers int, mappingFunc func(ctx context.Context, item T1) (T2, error)) ([]T2, error) {
+	if workers <= 0 {
+		return nil, fmt.Errorf("parallelMap: workers must be positive, got %d", workers)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result := make([]T2, len(source))
+	indexes := make(chan int)
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for w := 0; w < workers && w < len(source); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				res, err := mappingFunc(ctx, source[idx])
+				if err != nil {
+					fail(errors.Wrap(err, fmt.Sprintf("error mapping at index:'%v', error", idx)))
+					continue
+				}
+				result[idx] = res
+			}
+		}()
+	}
+
+feed:
+	for idx := range source {
+		select {
+		case indexes <- idx:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ProcessChunksParallel splits the source into chunks of chunkSize, processes up to workers chunks
+// concurrently and reassembles the results in the original order.
+// The first error cancels the remaining chunks and is returned wrapped with the chunk index.
+func ProcessChunksParallel[T1 any, T2 any](ctx context.Context, source []T1, chunkSize, workers int, processFunc func(ctx context.Context, chunk []T1) ([]T2, error)) ([]T2, error) {
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("processChunksParallel: chunkSize must be positive, got %d", chunkSize)
+	}
+
+	chunks := [][]T1{}
+	for start := 0; start < len(source); start += chunkSize {
+		end := start + chunkSize
+		if end > len(source) {
+			end = len(source)
+		}
+		chunks = append(chunks, source[start:end:end])
+	}
+
+	processed, err := ParallelMap(ctx, chunks, workers, processFunc)
+	if err != nil {
+		return nil, errors.Wrap(err, "error processing chunk")
+	}
+
+	result := []T2{}
+	for _, chunk := range processed {
+		result = append(result, chunk...)
+	}
+	return result, nil
+}
@@ -0,0 +1,132 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMap(t *testing.T) {
+	t.Run("keeps original order", func(t *testing.T) {
+		source := []int{5, 4, 3, 2, 1}
+		result, err := ParallelMap(context.Background(), source, 3, func(ctx context.Context, item int) (int, error) {
+			time.Sleep(time.Duration(item) * time.Millisecond)
+			return item * 10, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{50, 40, 30, 20, 10}, result)
+	})
+
+	t.Run("limits concurrency", func(t *testing.T) {
+		var running, peak int32
+		_, err := ParallelMap(context.Background(), make([]int, 20), 2, func(ctx context.Context, item int) (int, error) {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				old := atomic.LoadInt32(&peak)
+				if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return item, nil
+		})
+
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, peak, int32(2))
+	})
+
+	t.Run("returns first error", func(t *testing.T) {
+		_, err := ParallelMap(context.Background(), []int{1, 2, 3}, 1, func(ctx context.Context, item int) (int, error) {
+			if item == 2 {
+				return 0, errors.New("boom")
+			}
+			return item, nil
+		})
+
+		assert.EqualError(t, err, "error mapping at index:'1', error: boom")
+	})
+
+	t.Run("honours cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := ParallelMap(ctx, []int{1, 2, 3}, 2, func(ctx context.Context, item int) (int, error) {
+			return item, ctx.Err()
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid workers", func(t *testing.T) {
+		_, err := ParallelMap(context.Background(), []int{1}, 0, func(ctx context.Context, item int) (int, error) { return item, nil })
+		assert.Error(t, err)
+	})
+}
+
+func TestProcessChunksParallel(t *testing.T) {
+	t.Run("reassembles chunks in order", func(t *testing.T) {
+		source := []int{1, 2, 3, 4, 5, 6, 7}
+		chunkSizes := make(chan int, 10)
+
+		result, err := ProcessChunksParallel(context.Background(), source, 3, 2, func(ctx context.Context, chunk []int) ([]string, error) {
+			chunkSizes <- len(chunk)
+			out := []string{}
+			for _, v := range chunk {
+				out = append(out, string(rune('a'+v-1)))
+			}
+			return out, nil
+		})
+		close(chunkSizes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e", "f", "g"}, result)
+		sizes := []int{}
+		for size := range chunkSizes {
+			sizes = append(sizes, size)
+		}
+		assert.ElementsMatch(t, []int{3, 3, 1}, sizes)
+	})
+
+	t.Run("chunks cannot append into neighbours", func(t *testing.T) {
+		source := []int{1, 2, 3, 4}
+		_, err := ProcessChunksParallel(context.Background(), source, 2, 1, func(ctx context.Context, chunk []int) ([]int, error) {
+			_ = append(chunk, 99)
+			return chunk, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, source)
+	})
+
+	t.Run("propagates errors", func(t *testing.T) {
+		_, err := ProcessChunksParallel(context.Background(), []int{1, 2, 3, 4}, 2, 2, func(ctx context.Context, chunk []int) ([]int, error) {
+			if chunk[0] == 3 {
+				return nil, errors.New("bad chunk")
+			}
+			return chunk, nil
+		})
+
+		assert.EqualError(t, err, "error processing chunk: error mapping at index:'1', error: bad chunk")
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		result, err := ProcessChunksParallel(context.Background(), []int{}, 2, 2, func(ctx context.Context, chunk []int) ([]int, error) {
+			return chunk, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, result)
+	})
+
+	t.Run("invalid chunk size", func(t *testing.T) {
+		_, err := ProcessChunksParallel(context.Background(), []int{1}, 0, 1, func(ctx context.Context, chunk []int) ([]int, error) {
+			return chunk, nil
+		})
+		assert.Error(t, err)
+	})
+}
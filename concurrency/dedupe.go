@@ -0,0 +1,57 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// Deduplicate wraps fn so that concurrent calls with the same key are collapsed into a single
+// execution whose result is shared by every caller. Calls made after it completes run fn again.
+// The shared execution runs on a context that keeps the values of the first caller's context but
+// not its cancellation; a caller whose own context is cancelled stops waiting and returns its
+// context error. If fn panics, every caller waiting for that execution panics with the same value.
+func Deduplicate[K comparable, V any](fn func(ctx context.Context, key K) (V, error)) func(ctx context.Context, key K) (V, error) {
+	type call struct {
+		done     chan struct{}
+		value    V
+		err      error
+		panicked any
+	}
+	var (
+		mu       sync.Mutex
+		inflight = make(map[K]*call)
+	)
+
+	run := func(ctx context.Context, key K, pending *call) {
+		defer func() {
+			pending.panicked = recover()
+			mu.Lock()
+			delete(inflight, key)
+			mu.Unlock()
+			close(pending.done)
+		}()
+		pending.value, pending.err = fn(ctx, key)
+	}
+
+	return func(ctx context.Context, key K) (V, error) {
+		mu.Lock()
+		pending, ok := inflight[key]
+		if !ok {
+			pending = &call{done: make(chan struct{})}
+			inflight[key] = pending
+			go run(context.WithoutCancel(ctx), key, pending)
+		}
+		mu.Unlock()
+
+		select {
+		case <-pending.done:
+			if pending.panicked != nil {
+				panic(pending.panicked)
+			}
+			return pending.value, pending.err
+		case <-ctx.Done():
+			var zero V
+			return zero, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeduplicate(t *testing.T) {
+	t.Run("collapses concurrent calls per key", func(t *testing.T) {
+		var calls int32
+		release := make(chan struct{})
+		fetch := Deduplicate(func(ctx context.Context, key string) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			<-release
+			return len(key), nil
+		})
+
+		var wg sync.WaitGroup
+		results := make([]int, 8)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				key := "abc"
+				if i%2 == 1 {
+					key = "abcdef"
+				}
+				results[i], _ = fetch(context.Background(), key)
+			}(i)
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+		assert.Equal(t, []int{3, 6, 3, 6, 3, 6, 3, 6}, results)
+	})
+
+	t.Run("errors are shared and not cached", func(t *testing.T) {
+		var calls int32
+		fetch := Deduplicate(func(ctx context.Context, key int) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			return 0, errors.New("down")
+		})
+
+		_, err := fetch(context.Background(), 1)
+		assert.EqualError(t, err, "down")
+		_, err = fetch(context.Background(), 1)
+		assert.EqualError(t, err, "down")
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("waiter honours its own context", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		fetch := Deduplicate(func(ctx context.Context, key int) (int, error) {
+			<-release
+			return key, nil
+		})
+		go func() { _, _ = fetch(context.Background(), 1) }()
+		time.Sleep(10 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := fetch(ctx, 1)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("a panic reaches every caller and is not cached", func(t *testing.T) {
+		var calls int32
+		release := make(chan struct{})
+		fetch := Deduplicate(func(ctx context.Context, key int) (int, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				<-release
+				panic("boom")
+			}
+			return key, nil
+		})
+
+		var wg sync.WaitGroup
+		panics := make([]any, 3)
+		for i := range panics {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { panics[i] = recover() }()
+				_, _ = fetch(context.Background(), 1)
+			}(i)
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, []any{"boom", "boom", "boom"}, panics)
+		value, err := fetch(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, value)
+	})
+
+	t.Run("cancelling the first caller does not fail the others", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		fetch := Deduplicate(func(ctx context.Context, key int) (int, error) {
+			close(started)
+			select {
+			case <-release:
+				return key, nil
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		})
+
+		first, cancelFirst := context.WithCancel(context.Background())
+		firstErr := make(chan error, 1)
+		go func() {
+			_, err := fetch(first, 7)
+			firstErr <- err
+		}()
+		<-started
+
+		second := make(chan int, 1)
+		go func() {
+			value, _ := fetch(context.Background(), 7)
+			second <- value
+		}()
+		time.Sleep(10 * time.Millisecond)
+		cancelFirst()
+		assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+		close(release)
+		assert.Equal(t, 7, <-second)
+	})
+}
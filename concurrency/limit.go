@@ -0,0 +1,130 @@
+package concurrency
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Limiter is a weighted semaphore bounding how much work runs at once.
+// A single Limiter can be shared by many call sites, e.g. several ParallelMap invocations,
+// to enforce one global concurrency budget. Waiters are served in FIFO order.
+type Limiter struct {
+	size    int64
+	mu      sync.Mutex
+	used    int64
+	waiters list.List
+}
+
+type waiter struct {
+	weight int64
+	ready  chan struct{}
+}
+
+// Limit creates a Limiter allowing a total weight of n to be held at the same time.
+func Limit(n int64) *Limiter {
+	return &Limiter{size: n}
+}
+
+// Acquire blocks until weight is available or ctx is done.
+func (l *Limiter) Acquire(ctx context.Context, weight int64) error {
+	if weight > l.size {
+		return fmt.Errorf("limiter: weight %d exceeds limit %d", weight, l.size)
+	}
+
+	l.mu.Lock()
+	if l.waiters.Len() == 0 && l.size-l.used >= weight {
+		l.used += weight
+		l.mu.Unlock()
+		return nil
+	}
+	w := waiter{weight: weight, ready: make(chan struct{})}
+	element := l.waiters.PushBack(w)
+	l.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		select {
+		case <-w.ready:
+			// Acquired while being cancelled; give the weight back.
+			l.used -= weight
+			l.notifyWaiters()
+		default:
+			isFront := l.waiters.Front() == element
+			l.waiters.Remove(element)
+			if isFront {
+				l.notifyWaiters()
+			}
+		}
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// TryAcquire acquires weight without blocking and reports whether it succeeded.
+func (l *Limiter) TryAcquire(weight int64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.waiters.Len() == 0 && l.size-l.used >= weight {
+		l.used += weight
+		return true
+	}
+	return false
+}
+
+// Release returns weight to the Limiter.
+func (l *Limiter) Release(weight int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.used -= weight
+	if l.used < 0 {
+		panic("limiter: released more than held")
+	}
+	l.notifyWaiters()
+}
+
+// notifyWaiters wakes waiters in order while their weight fits. l.mu must be held.
+func (l *Limiter) notifyWaiters() {
+	for {
+		front := l.waiters.Front()
+		if front == nil {
+			return
+		}
+		w := front.Value.(waiter)
+		if l.size-l.used < w.weight {
+			return
+		}
+		l.used += w.weight
+		l.waiters.Remove(front)
+		close(w.ready)
+	}
+}
+
+// Guard wraps fn so that every call holds one unit of the Limiter while running.
+func Guard[T any](l *Limiter, fn func(ctx context.Context) (T, error)) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		if err := l.Acquire(ctx, 1); err != nil {
+			var zero T
+			return zero, err
+		}
+		defer l.Release(1)
+		return fn(ctx)
+	}
+}
+
+// GuardItem wraps a per-item function, such as a ParallelMap mapping function,
+// so that every call holds one unit of the Limiter while running.
+func GuardItem[T1 any, T2 any](l *Limiter, fn func(ctx context.Context, item T1) (T2, error)) func(ctx context.Context, item T1) (T2, error) {
+	return func(ctx context.Context, item T1) (T2, error) {
+		if err := l.Acquire(ctx, 1); err != nil {
+			var zero T2
+			return zero, err
+		}
+		defer l.Release(1)
+		return fn(ctx, item)
+	}
+}
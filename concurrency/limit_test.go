@@ -0,0 +1,103 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Run("weighted acquire and release", func(t *testing.T) {
+		l := Limit(3)
+
+		assert.NoError(t, l.Acquire(context.Background(), 2))
+		assert.False(t, l.TryAcquire(2))
+		assert.True(t, l.TryAcquire(1))
+		l.Release(3)
+		assert.True(t, l.TryAcquire(3))
+	})
+
+	t.Run("weight above the limit fails", func(t *testing.T) {
+		assert.Error(t, Limit(1).Acquire(context.Background(), 2))
+	})
+
+	t.Run("blocked acquire honours context", func(t *testing.T) {
+		l := Limit(1)
+		assert.True(t, l.TryAcquire(1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, l.Acquire(ctx, 1), context.DeadlineExceeded)
+
+		l.Release(1)
+		assert.True(t, l.TryAcquire(1))
+	})
+
+	t.Run("waiters are woken on release", func(t *testing.T) {
+		l := Limit(1)
+		assert.True(t, l.TryAcquire(1))
+
+		acquired := make(chan struct{})
+		go func() {
+			_ = l.Acquire(context.Background(), 1)
+			close(acquired)
+		}()
+		time.Sleep(5 * time.Millisecond)
+		l.Release(1)
+
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("waiter was not woken")
+		}
+	})
+}
+
+func TestGuard(t *testing.T) {
+	t.Run("shared budget across ParallelMap calls", func(t *testing.T) {
+		l := Limit(2)
+		var running, peak int32
+		work := GuardItem(l, func(ctx context.Context, item int) (int, error) {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				old := atomic.LoadInt32(&peak)
+				if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return item, nil
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := ParallelMap(context.Background(), make([]int, 10), 4, work)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+	})
+
+	t.Run("guarded function", func(t *testing.T) {
+		l := Limit(1)
+		guarded := Guard(l, func(ctx context.Context) (string, error) {
+			assert.False(t, l.TryAcquire(1))
+			return "ok", nil
+		})
+
+		value, err := guarded(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", value)
+		assert.True(t, l.TryAcquire(1))
+	})
+}
@@ -0,0 +1,98 @@
+package concurrency
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+// MapReduceOptions configures MapReduce.
+type MapReduceOptions struct {
+	Mappers    int // number of concurrent map workers, defaults to 1
+	Partitions int // number of hash partitions reduced concurrently, defaults to Mappers
+}
+
+// MapReduce runs an in-process map/shuffle/reduce over the input.
+// mapFn emits key/value pairs for every item, the pairs are hash-partitioned by key,
+// and reduceFn folds all values of a key (in input order) into the final result.
+// Example (word count):
+//   - MapReduce(ctx, lines, splitWords, func(word string, ones []int) int { return len(ones) }, MapReduceOptions{Mappers: 4})
+func MapReduce[T any, K comparable, V any, R any](ctx context.Context, input []T, mapFn func(item T) []tuple.Pair[K, V], reduceFn func(key K, values []V) R, opts MapReduceOptions) (map[K]R, error) {
+	if opts.Mappers <= 0 {
+		opts.Mappers = 1
+	}
+	if opts.Partitions <= 0 {
+		opts.Partitions = opts.Mappers
+	}
+
+	// Map phase: each mapper owns a contiguous slice of the input and groups its own output by key.
+	chunkSize := (len(input) + opts.Mappers - 1) / opts.Mappers
+	if chunkSize == 0 {
+		chunkSize = 1
+	}
+	mapped, err := ProcessChunksParallel(ctx, input, chunkSize, opts.Mappers, func(ctx context.Context, chunk []T) ([][]tuple.Pair[K, []V], error) {
+		grouped := make(map[K][]V)
+		keys := []K{}
+		for _, item := range chunk {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			for _, pair := range mapFn(item) {
+				if _, seen := grouped[pair.First]; !seen {
+					keys = append(keys, pair.First)
+				}
+				grouped[pair.First] = append(grouped[pair.First], pair.Second)
+			}
+		}
+		result := make([]tuple.Pair[K, []V], 0, len(keys))
+		for _, key := range keys {
+			result = append(result, tuple.NewPair(key, grouped[key]))
+		}
+		return [][]tuple.Pair[K, []V]{result}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mapReduce: map phase: %w", err)
+	}
+
+	// Shuffle phase: hash-partition the mapper outputs by key, keeping the input order of values.
+	shuffled := make([]map[K][]V, opts.Partitions)
+	for p := range shuffled {
+		shuffled[p] = make(map[K][]V)
+	}
+	for _, mapperOutput := range mapped {
+		for _, pair := range mapperOutput {
+			p := partitionOf(pair.First, opts.Partitions)
+			shuffled[p][pair.First] = append(shuffled[p][pair.First], pair.Second...)
+		}
+	}
+
+	// Reduce phase: partitions are reduced concurrently.
+	result := make(map[K]R)
+	var mu sync.Mutex
+	_, err = ParallelMap(ctx, shuffled, opts.Partitions, func(ctx context.Context, partition map[K][]V) (struct{}, error) {
+		for key, values := range partition {
+			if err := ctx.Err(); err != nil {
+				return struct{}{}, err
+			}
+			reduced := reduceFn(key, values)
+			mu.Lock()
+			result[key] = reduced
+			mu.Unlock()
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mapReduce: reduce phase: %w", err)
+	}
+	return result, nil
+}
+
+// partitionOf assigns a key to a partition using the hash of its formatted value.
+func partitionOf[K comparable](key K, partitions int) int {
+	h := fnv.New32a()
+	_, _ = fmt.Fprintf(h, "%v", key)
+	return int(h.Sum32() % uint32(partitions))
+}
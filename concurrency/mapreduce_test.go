@@ -0,0 +1,59 @@
+package concurrency
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+func TestMapReduce(t *testing.T) {
+	splitWords := func(line string) []tuple.Pair[string, int] {
+		pairs := []tuple.Pair[string, int]{}
+		for _, word := range strings.Fields(line) {
+			pairs = append(pairs, tuple.NewPair(strings.ToLower(word), 1))
+		}
+		return pairs
+	}
+	countWords := func(word string, ones []int) int { return len(ones) }
+
+	t.Run("word count", func(t *testing.T) {
+		lines := []string{"the quick fox", "The lazy dog", "the fox"}
+
+		result, err := MapReduce(context.Background(), lines, splitWords, countWords, MapReduceOptions{Mappers: 2, Partitions: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"the": 3, "quick": 1, "fox": 2, "lazy": 1, "dog": 1}, result)
+	})
+
+	t.Run("values keep input order", func(t *testing.T) {
+		input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		byParity := func(item int) []tuple.Pair[bool, int] {
+			return []tuple.Pair[bool, int]{tuple.NewPair(item%2 == 0, item)}
+		}
+		collect := func(even bool, values []int) []int { return values }
+
+		result, err := MapReduce(context.Background(), input, byParity, collect, MapReduceOptions{Mappers: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[bool][]int{false: {1, 3, 5, 7}, true: {2, 4, 6, 8}}, result)
+	})
+
+	t.Run("default options and empty input", func(t *testing.T) {
+		result, err := MapReduce(context.Background(), []string{}, splitWords, countWords, MapReduceOptions{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := MapReduce(ctx, []string{"a b"}, splitWords, countWords, MapReduceOptions{Mappers: 2})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
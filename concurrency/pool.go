@@ -0,0 +1,89 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// PoolOptions configures a Pool.
+type PoolOptions[T any] struct {
+	New     func() T // creates a resource when no idle one is available
+	Reset   func(T)  // prepares a returned resource for reuse, optional
+	MaxSize int      // caps how many resources are borrowed at once; zero means unbounded
+}
+
+// Pool keeps reusable resources such as buffers, encoders or prepared statements.
+// It is safe for concurrent use.
+type Pool[T any] struct {
+	opts    PoolOptions[T]
+	limiter *Limiter
+	mu      sync.Mutex
+	idle    []T
+}
+
+// NewPool creates a Pool from opts; opts.New is required.
+func NewPool[T any](opts PoolOptions[T]) *Pool[T] {
+	pool := &Pool[T]{opts: opts}
+	if opts.MaxSize > 0 {
+		pool.limiter = Limit(int64(opts.MaxSize))
+	}
+	return pool
+}
+
+// Get borrows a resource, waiting while MaxSize resources are borrowed.
+// Every successful Get must be followed by a Put.
+func (p *Pool[T]) Get(ctx context.Context) (T, error) {
+	if p.limiter != nil {
+		if err := p.limiter.Acquire(ctx, 1); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+
+	p.mu.Lock()
+	if n := len(p.idle); n > 0 {
+		resource := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return resource, nil
+	}
+	p.mu.Unlock()
+	return p.opts.New(), nil
+}
+
+// Put resets a borrowed resource and returns it to the pool.
+func (p *Pool[T]) Put(resource T) {
+	if p.opts.Reset != nil {
+		p.opts.Reset(resource)
+	}
+	p.mu.Lock()
+	p.idle = append(p.idle, resource)
+	p.mu.Unlock()
+	if p.limiter != nil {
+		p.limiter.Release(1)
+	}
+}
+
+// WithResource borrows a resource for the duration of fn.
+// The resource is returned to the pool even if fn panics.
+func (p *Pool[T]) WithResource(ctx context.Context, fn func(resource T) error) error {
+	resource, err := p.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer p.Put(resource)
+	return fn(resource)
+}
+
+// MapPooled works like ParallelMap, lending every call a resource from the pool.
+func MapPooled[T1 any, T2 any, R any](ctx context.Context, source []T1, workers int, pool *Pool[R], mappingFunc func(ctx context.Context, resource R, item T1) (T2, error)) ([]T2, error) {
+	return ParallelMap(ctx, source, workers, func(ctx context.Context, item T1) (T2, error) {
+		var result T2
+		err := pool.WithResource(ctx, func(resource R) error {
+			var err error
+			result, err = mappingFunc(ctx, resource, item)
+			return err
+		})
+		return result, err
+	})
+}
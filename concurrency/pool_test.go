@@ -0,0 +1,93 @@
+package concurrency
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newBufferPool(created *int32, maxSize int) *Pool[*bytes.Buffer] {
+	return NewPool(PoolOptions[*bytes.Buffer]{
+		New: func() *bytes.Buffer {
+			atomic.AddInt32(created, 1)
+			return &bytes.Buffer{}
+		},
+		Reset:   func(b *bytes.Buffer) { b.Reset() },
+		MaxSize: maxSize,
+	})
+}
+
+func TestPool(t *testing.T) {
+	t.Run("reuses and resets resources", func(t *testing.T) {
+		var created int32
+		pool := newBufferPool(&created, 0)
+
+		assert.NoError(t, pool.WithResource(context.Background(), func(b *bytes.Buffer) error {
+			b.WriteString("dirty")
+			return nil
+		}))
+		assert.NoError(t, pool.WithResource(context.Background(), func(b *bytes.Buffer) error {
+			assert.Equal(t, 0, b.Len())
+			return nil
+		}))
+		assert.Equal(t, int32(1), created)
+	})
+
+	t.Run("returns the resource on panic", func(t *testing.T) {
+		var created int32
+		pool := newBufferPool(&created, 1)
+
+		assert.Panics(t, func() {
+			_ = pool.WithResource(context.Background(), func(b *bytes.Buffer) error { panic("boom") })
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		assert.NoError(t, pool.WithResource(ctx, func(b *bytes.Buffer) error { return nil }))
+	})
+
+	t.Run("propagates errors", func(t *testing.T) {
+		var created int32
+		pool := newBufferPool(&created, 0)
+
+		err := pool.WithResource(context.Background(), func(b *bytes.Buffer) error { return errors.New("fail") })
+		assert.EqualError(t, err, "fail")
+	})
+
+	t.Run("max size blocks until a resource is returned", func(t *testing.T) {
+		var created int32
+		pool := newBufferPool(&created, 1)
+		held, err := pool.Get(context.Background())
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		_, err = pool.Get(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		pool.Put(held)
+		_, err = pool.Get(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func TestMapPooled(t *testing.T) {
+	var created int32
+	pool := newBufferPool(&created, 2)
+
+	result, err := MapPooled(context.Background(), []int{1, 2, 3, 4, 5, 6}, 4, pool, func(ctx context.Context, b *bytes.Buffer, item int) (string, error) {
+		b.WriteString("n")
+		b.WriteString(strconv.Itoa(item))
+		return b.String(), nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"n1", "n2", "n3", "n4", "n5", "n6"}, result)
+	assert.LessOrEqual(t, atomic.LoadInt32(&created), int32(2))
+}
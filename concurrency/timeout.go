@@ -0,0 +1,72 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TimeoutError is returned when a call wrapped by WithTimeout or WithDeadline overruns its limit.
+// It matches context.DeadlineExceeded with errors.Is.
+type TimeoutError struct {
+	Deadline time.Time     // the deadline that was exceeded
+	Limit    time.Duration // the configured timeout, zero for WithDeadline
+}
+
+func (e *TimeoutError) Error() string {
+	if e.Limit > 0 {
+		return fmt.Sprintf("call timed out after %v", e.Limit)
+	}
+	return fmt.Sprintf("call exceeded deadline %v", e.Deadline.Format(time.RFC3339Nano))
+}
+
+// Unwrap allows errors.Is(err, context.DeadlineExceeded).
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// WithTimeout wraps fn so that every call is limited to d.
+// The call returns a *TimeoutError as soon as the limit is reached, even if fn ignores its context;
+// cancellation of the caller's own context is reported unchanged.
+func WithTimeout[T any](fn func(ctx context.Context) (T, error), d time.Duration) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		return callWithDeadline(ctx, fn, time.Now().Add(d), d)
+	}
+}
+
+// WithDeadline wraps fn so that every call must complete before deadline.
+func WithDeadline[T any](fn func(ctx context.Context) (T, error), deadline time.Time) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		return callWithDeadline(ctx, fn, deadline, 0)
+	}
+}
+
+func callWithDeadline[T any](parent context.Context, fn func(ctx context.Context) (T, error), deadline time.Time, limit time.Duration) (T, error) {
+	ctx, cancel := context.WithDeadline(parent, deadline)
+	defer cancel()
+
+	type outcome struct {
+		value T
+		err   error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		value, err := fn(ctx)
+		done <- outcome{value: value, err: err}
+	}()
+
+	var zero T
+	select {
+	case result := <-done:
+		if result.err != nil && errors.Is(result.err, context.DeadlineExceeded) && parent.Err() == nil {
+			return zero, &TimeoutError{Deadline: deadline, Limit: limit}
+		}
+		return result.value, result.err
+	case <-ctx.Done():
+		if err := parent.Err(); err != nil {
+			return zero, err
+		}
+		return zero, &TimeoutError{Deadline: deadline, Limit: limit}
+	}
+}
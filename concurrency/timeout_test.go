@@ -0,0 +1,76 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	slow := func(d time.Duration) func(ctx context.Context) (string, error) {
+		return func(ctx context.Context) (string, error) {
+			time.Sleep(d)
+			return "done", nil
+		}
+	}
+
+	t.Run("fast call succeeds", func(t *testing.T) {
+		value, err := WithTimeout(slow(0), time.Second)(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "done", value)
+	})
+
+	t.Run("slow call returns TimeoutError even when ignoring the context", func(t *testing.T) {
+		start := time.Now()
+		_, err := WithTimeout(slow(500*time.Millisecond), 10*time.Millisecond)(context.Background())
+
+		var timeoutErr *TimeoutError
+		assert.True(t, errors.As(err, &timeoutErr))
+		assert.Equal(t, 10*time.Millisecond, timeoutErr.Limit)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.EqualError(t, err, "call timed out after 10ms")
+		assert.Less(t, time.Since(start), 400*time.Millisecond)
+	})
+
+	t.Run("context-aware call error is converted", func(t *testing.T) {
+		_, err := WithTimeout(func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}, 5*time.Millisecond)(context.Background())
+
+		var timeoutErr *TimeoutError
+		assert.True(t, errors.As(err, &timeoutErr))
+	})
+
+	t.Run("parent cancellation is reported as is", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := WithTimeout(slow(50*time.Millisecond), time.Second)(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("function errors pass through", func(t *testing.T) {
+		_, err := WithTimeout(func(ctx context.Context) (int, error) {
+			return 0, errors.New("boom")
+		}, time.Second)(context.Background())
+		assert.EqualError(t, err, "boom")
+	})
+}
+
+func TestWithDeadline(t *testing.T) {
+	deadline := time.Now().Add(10 * time.Millisecond)
+	_, err := WithDeadline(func(ctx context.Context) (int, error) {
+		time.Sleep(200 * time.Millisecond)
+		return 1, nil
+	}, deadline)(context.Background())
+
+	var timeoutErr *TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, deadline, timeoutErr.Deadline)
+	assert.Contains(t, err.Error(), "call exceeded deadline")
+}
@@ -37,3 +37,16 @@ func ForAll[T any](elements []T, condition func(T) bool) bool {
 	}
 	return true
 }
+
+// When returns a function applying fn to inputs that satisfy the predicate and returning
+// other inputs unchanged, so conditional steps can be used inside Compose or Chain.
+// Example:
+//   - Chain(price, When(isMember, applyDiscount), roundToCents)
+func When[T any](predicate func(T) bool, fn func(T) T) func(T) T {
+	return func(value T) T {
+		if predicate(value) {
+			return fn(value)
+		}
+		return value
+	}
+}
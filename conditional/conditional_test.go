@@ -138,3 +138,13 @@ func TestAll(t *testing.T) {
 	})
 
 }
+
+func TestWhen(t *testing.T) {
+	isNegative := func(n int) bool { return n < 0 }
+	negate := func(n int) int { return -n }
+	abs := When(isNegative, negate)
+
+	assert.Equal(t, 3, abs(-3))
+	assert.Equal(t, 3, abs(3))
+	assert.Equal(t, 0, abs(0))
+}
@@ -0,0 +1,44 @@
+package conditional
+
+// Matcher evaluates the first matching case of a Match expression. Cases are checked in order
+// and only the handler of the first matching case runs.
+type Matcher[R any, T comparable] struct {
+	value   T
+	result  R
+	matched bool
+}
+
+// Match starts a pattern-matching expression on value that evaluates to an R.
+// The result type is given explicitly and the value type is inferred.
+// Example:
+//   - Match[string](status).WhenValue(200, ok).When(isServerError, retry).Otherwise(fail)
+func Match[R any, T comparable](value T) *Matcher[R, T] {
+	return &Matcher[R, T]{value: value}
+}
+
+// When adds a case that matches values satisfying the predicate.
+func (m *Matcher[R, T]) When(predicate func(T) bool, fn func(T) R) *Matcher[R, T] {
+	if !m.matched && predicate(m.value) {
+		m.result = fn(m.value)
+		m.matched = true
+	}
+	return m
+}
+
+// WhenValue adds a case that matches values equal to expected.
+func (m *Matcher[R, T]) WhenValue(expected T, fn func(T) R) *Matcher[R, T] {
+	return m.When(func(value T) bool { return value == expected }, fn)
+}
+
+// Otherwise returns the result of the matching case, or fn(value) when no case matched.
+func (m *Matcher[R, T]) Otherwise(fn func(T) R) R {
+	if !m.matched {
+		return fn(m.value)
+	}
+	return m.result
+}
+
+// Result returns the result of the matching case and whether any case matched.
+func (m *Matcher[R, T]) Result() (R, bool) {
+	return m.result, m.matched
+}
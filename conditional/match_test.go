@@ -0,0 +1,43 @@
+package conditional
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	describe := func(status int) string {
+		return Match[string](status).
+			WhenValue(200, func(int) string { return "ok" }).
+			WhenValue(404, func(int) string { return "not found" }).
+			When(func(s int) bool { return s >= 500 }, func(s int) string { return fmt.Sprintf("server error %d", s) }).
+			Otherwise(func(s int) string { return fmt.Sprintf("unexpected %d", s) })
+	}
+
+	assert.Equal(t, "ok", describe(200))
+	assert.Equal(t, "not found", describe(404))
+	assert.Equal(t, "server error 503", describe(503))
+	assert.Equal(t, "unexpected 302", describe(302))
+
+	t.Run("only the first matching case runs", func(t *testing.T) {
+		calls := 0
+		count := func(n int) int { calls++; return n }
+		result := Match[int](5).
+			When(func(n int) bool { return n > 0 }, count).
+			When(func(n int) bool { return n > 1 }, count).
+			Otherwise(func(int) int { return -1 })
+		assert.Equal(t, 5, result)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Result", func(t *testing.T) {
+		_, ok := Match[string]("x").WhenValue("y", func(string) string { return "y" }).Result()
+		assert.False(t, ok)
+
+		result, ok := Match[bool]("y").WhenValue("y", func(string) bool { return true }).Result()
+		assert.True(t, ok)
+		assert.True(t, result)
+	})
+}
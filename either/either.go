@@ -0,0 +1,96 @@
+// Package either provides Either, a value holding one of two alternatives.
+package either
+
+import (
+	result "github.com/lumiluminousai/golang-fp-utility/result"
+)
+
+// Either holds either a Left value or a Right value. By convention Right is the expected case
+// and Left carries the alternative, such as validation details.
+// The zero value is a Left holding the zero L.
+type Either[L any, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left creates an Either holding a left value.
+func Left[L any, R any](value L) Either[L, R] {
+	return Either[L, R]{left: value}
+}
+
+// Right creates an Either holding a right value.
+func Right[L any, R any](value R) Either[L, R] {
+	return Either[L, R]{right: value, isRight: true}
+}
+
+// IsLeft reports whether the Either holds a left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight reports whether the Either holds a right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// GetLeft returns the left value and whether it is present.
+func (e Either[L, R]) GetLeft() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// GetRight returns the right value and whether it is present.
+func (e Either[L, R]) GetRight() (R, bool) {
+	return e.right, e.isRight
+}
+
+// Swap exchanges the sides.
+func (e Either[L, R]) Swap() Either[R, L] {
+	if e.isRight {
+		return Left[R, L](e.right)
+	}
+	return Right[R, L](e.left)
+}
+
+// Fold collapses an Either into a single value by applying the function for its side.
+// Example:
+//   - Fold(parsed, func(errs []string) int { return 400 }, func(User) int { return 200 })
+func Fold[L any, R any, T any](source Either[L, R], leftFn func(L) T, rightFn func(R) T) T {
+	if source.isRight {
+		return rightFn(source.right)
+	}
+	return leftFn(source.left)
+}
+
+// MapLeft transforms a left value and passes a right value through.
+func MapLeft[L1 any, L2 any, R any](source Either[L1, R], transform func(L1) L2) Either[L2, R] {
+	if source.isRight {
+		return Right[L2](source.right)
+	}
+	return Left[L2, R](transform(source.left))
+}
+
+// MapRight transforms a right value and passes a left value through.
+func MapRight[L any, R1 any, R2 any](source Either[L, R1], transform func(R1) R2) Either[L, R2] {
+	if source.isRight {
+		return Right[L](transform(source.right))
+	}
+	return Left[L, R2](source.left)
+}
+
+// FromResult converts a Result into an Either with the error on the left.
+func FromResult[T any](source result.Result[T]) Either[error, T] {
+	value, err := source.ToTuple()
+	if err != nil {
+		return Left[error, T](err)
+	}
+	return Right[error](value)
+}
+
+// ToResult converts an Either with an error on the left into a Result.
+func ToResult[T any](source Either[error, T]) result.Result[T] {
+	if source.isRight {
+		return result.Ok(source.right)
+	}
+	return result.Err[T](source.left)
+}
@@ -0,0 +1,79 @@
+package either
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	result "github.com/lumiluminousai/golang-fp-utility/result"
+)
+
+type validation struct {
+	Field   string
+	Message string
+}
+
+func TestLeftAndRight(t *testing.T) {
+	t.Run("Left", func(t *testing.T) {
+		e := Left[validation, int](validation{"age", "required"})
+		assert.True(t, e.IsLeft())
+		assert.False(t, e.IsRight())
+		left, ok := e.GetLeft()
+		assert.True(t, ok)
+		assert.Equal(t, "age", left.Field)
+		_, ok = e.GetRight()
+		assert.False(t, ok)
+	})
+
+	t.Run("Right", func(t *testing.T) {
+		e := Right[validation](42)
+		assert.True(t, e.IsRight())
+		right, ok := e.GetRight()
+		assert.True(t, ok)
+		assert.Equal(t, 42, right)
+	})
+
+	t.Run("zero value is Left", func(t *testing.T) {
+		var e Either[string, int]
+		assert.True(t, e.IsLeft())
+	})
+}
+
+func TestSwap(t *testing.T) {
+	assert.Equal(t, Right[int]("x"), Left[string, int]("x").Swap())
+	assert.Equal(t, Left[int, string](1), Right[string](1).Swap())
+}
+
+func TestFold(t *testing.T) {
+	status := func(e Either[[]validation, string]) int {
+		return Fold(e, func([]validation) int { return 400 }, func(string) int { return 200 })
+	}
+	assert.Equal(t, 400, status(Left[[]validation, string]([]validation{{"name", "too short"}})))
+	assert.Equal(t, 200, status(Right[[]validation]("ok")))
+}
+
+func TestMapLeftAndRight(t *testing.T) {
+	message := func(v validation) string { return v.Field + ": " + v.Message }
+	double := func(n int) int { return n * 2 }
+
+	left := Left[validation, int](validation{"age", "required"})
+	assert.Equal(t, Left[string, int]("age: required"), MapLeft(left, message))
+	assert.Equal(t, Left[validation, int](validation{"age", "required"}), MapRight(left, double))
+
+	right := Right[validation](21)
+	assert.Equal(t, Right[validation](42), MapRight(right, double))
+	assert.Equal(t, Right[string](21), MapLeft(right, message))
+}
+
+func TestResultConversion(t *testing.T) {
+	ok := FromResult(result.FromTuple(strconv.Atoi("7")))
+	assert.Equal(t, Right[error](7), ok)
+	assert.Equal(t, result.Ok(7), ToResult(ok))
+
+	boom := errors.New("boom")
+	failed := FromResult(result.Err[int](boom))
+	assert.True(t, failed.IsLeft())
+	assert.Equal(t, result.Err[int](boom), ToResult(failed))
+}
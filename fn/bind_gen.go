@@ -0,0 +1,203 @@
+// Code generated by genbind; DO NOT EDIT.
+
+package fn
+
+// Bind2At1 fixes parameter 1 of a two-parameter function.
+// The result takes parameter 2.
+func Bind2At1[T1, T2, R any](fn func(T1, T2) R, t1 T1) func(T2) R {
+	return func(t2 T2) R {
+		return fn(t1, t2)
+	}
+}
+
+// Bind2At2 fixes parameter 2 of a two-parameter function.
+// The result takes parameter 1.
+func Bind2At2[T1, T2, R any](fn func(T1, T2) R, t2 T2) func(T1) R {
+	return func(t1 T1) R {
+		return fn(t1, t2)
+	}
+}
+
+// Bind2At12 fixes parameters 1 and 2 of a two-parameter function.
+// The result takes no arguments.
+func Bind2At12[T1, T2, R any](fn func(T1, T2) R, t1 T1, t2 T2) func() R {
+	return func() R {
+		return fn(t1, t2)
+	}
+}
+
+// Bind3At1 fixes parameter 1 of a three-parameter function.
+// The result takes parameters 2 and 3.
+func Bind3At1[T1, T2, T3, R any](fn func(T1, T2, T3) R, t1 T1) func(T2, T3) R {
+	return func(t2 T2, t3 T3) R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// Bind3At2 fixes parameter 2 of a three-parameter function.
+// The result takes parameters 1 and 3.
+func Bind3At2[T1, T2, T3, R any](fn func(T1, T2, T3) R, t2 T2) func(T1, T3) R {
+	return func(t1 T1, t3 T3) R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// Bind3At12 fixes parameters 1 and 2 of a three-parameter function.
+// The result takes parameter 3.
+func Bind3At12[T1, T2, T3, R any](fn func(T1, T2, T3) R, t1 T1, t2 T2) func(T3) R {
+	return func(t3 T3) R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// Bind3At3 fixes parameter 3 of a three-parameter function.
+// The result takes parameters 1 and 2.
+func Bind3At3[T1, T2, T3, R any](fn func(T1, T2, T3) R, t3 T3) func(T1, T2) R {
+	return func(t1 T1, t2 T2) R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// Bind3At13 fixes parameters 1 and 3 of a three-parameter function.
+// The result takes parameter 2.
+func Bind3At13[T1, T2, T3, R any](fn func(T1, T2, T3) R, t1 T1, t3 T3) func(T2) R {
+	return func(t2 T2) R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// Bind3At23 fixes parameters 2 and 3 of a three-parameter function.
+// The result takes parameter 1.
+func Bind3At23[T1, T2, T3, R any](fn func(T1, T2, T3) R, t2 T2, t3 T3) func(T1) R {
+	return func(t1 T1) R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// Bind3At123 fixes parameters 1, 2 and 3 of a three-parameter function.
+// The result takes no arguments.
+func Bind3At123[T1, T2, T3, R any](fn func(T1, T2, T3) R, t1 T1, t2 T2, t3 T3) func() R {
+	return func() R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// Bind4At1 fixes parameter 1 of a four-parameter function.
+// The result takes parameters 2, 3 and 4.
+func Bind4At1[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1) func(T2, T3, T4) R {
+	return func(t2 T2, t3 T3, t4 T4) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At2 fixes parameter 2 of a four-parameter function.
+// The result takes parameters 1, 3 and 4.
+func Bind4At2[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t2 T2) func(T1, T3, T4) R {
+	return func(t1 T1, t3 T3, t4 T4) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At12 fixes parameters 1 and 2 of a four-parameter function.
+// The result takes parameters 3 and 4.
+func Bind4At12[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1, t2 T2) func(T3, T4) R {
+	return func(t3 T3, t4 T4) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At3 fixes parameter 3 of a four-parameter function.
+// The result takes parameters 1, 2 and 4.
+func Bind4At3[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t3 T3) func(T1, T2, T4) R {
+	return func(t1 T1, t2 T2, t4 T4) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At13 fixes parameters 1 and 3 of a four-parameter function.
+// The result takes parameters 2 and 4.
+func Bind4At13[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1, t3 T3) func(T2, T4) R {
+	return func(t2 T2, t4 T4) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At23 fixes parameters 2 and 3 of a four-parameter function.
+// The result takes parameters 1 and 4.
+func Bind4At23[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t2 T2, t3 T3) func(T1, T4) R {
+	return func(t1 T1, t4 T4) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At123 fixes parameters 1, 2 and 3 of a four-parameter function.
+// The result takes parameter 4.
+func Bind4At123[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1, t2 T2, t3 T3) func(T4) R {
+	return func(t4 T4) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At4 fixes parameter 4 of a four-parameter function.
+// The result takes parameters 1, 2 and 3.
+func Bind4At4[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t4 T4) func(T1, T2, T3) R {
+	return func(t1 T1, t2 T2, t3 T3) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At14 fixes parameters 1 and 4 of a four-parameter function.
+// The result takes parameters 2 and 3.
+func Bind4At14[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1, t4 T4) func(T2, T3) R {
+	return func(t2 T2, t3 T3) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At24 fixes parameters 2 and 4 of a four-parameter function.
+// The result takes parameters 1 and 3.
+func Bind4At24[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t2 T2, t4 T4) func(T1, T3) R {
+	return func(t1 T1, t3 T3) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At124 fixes parameters 1, 2 and 4 of a four-parameter function.
+// The result takes parameter 3.
+func Bind4At124[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1, t2 T2, t4 T4) func(T3) R {
+	return func(t3 T3) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At34 fixes parameters 3 and 4 of a four-parameter function.
+// The result takes parameters 1 and 2.
+func Bind4At34[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t3 T3, t4 T4) func(T1, T2) R {
+	return func(t1 T1, t2 T2) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At134 fixes parameters 1, 3 and 4 of a four-parameter function.
+// The result takes parameter 2.
+func Bind4At134[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1, t3 T3, t4 T4) func(T2) R {
+	return func(t2 T2) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At234 fixes parameters 2, 3 and 4 of a four-parameter function.
+// The result takes parameter 1.
+func Bind4At234[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t2 T2, t3 T3, t4 T4) func(T1) R {
+	return func(t1 T1) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// Bind4At1234 fixes parameters 1, 2, 3 and 4 of a four-parameter function.
+// The result takes no arguments.
+func Bind4At1234[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1, t2 T2, t3 T3, t4 T4) func() R {
+	return func() R {
+		return fn(t1, t2, t3, t4)
+	}
+}
@@ -0,0 +1,43 @@
+package fn
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBind(t *testing.T) {
+	describe := func(name string, age int, active bool) string {
+		return fmt.Sprintf("%s:%d:%v", name, age, active)
+	}
+
+	t.Run("fix a middle parameter", func(t *testing.T) {
+		bound := Bind3At2(describe, 10)
+		assert.Equal(t, "bob:10:true", bound("bob", true))
+	})
+
+	t.Run("fix a trailing parameter", func(t *testing.T) {
+		triple := Bind2At2(strings.Repeat, 3)
+		assert.Equal(t, "ababab", triple("ab"))
+	})
+
+	t.Run("fix everything", func(t *testing.T) {
+		bound := Bind3At123(describe, "x", 1, false)
+		assert.Equal(t, "x:1:false", bound())
+	})
+
+	t.Run("fix non-adjacent parameters", func(t *testing.T) {
+		join := func(sep string, a, b, c any) string {
+			return fmt.Sprint(a) + sep + fmt.Sprint(b) + sep + fmt.Sprint(c)
+		}
+		bound := Bind4At13(join, "-", any(2))
+		assert.Equal(t, "1-2-<nil>", bound(1, nil))
+	})
+
+	t.Run("fix leading parameters", func(t *testing.T) {
+		replace := Bind4At12(strings.Replace, "a-b-c", "-")
+		assert.Equal(t, "a+b-c", replace("+", 1))
+	})
+}
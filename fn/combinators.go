@@ -0,0 +1,35 @@
+package fn
+
+// Identity returns a function that returns its argument unchanged.
+func Identity[T any]() func(T) T {
+	return func(value T) T {
+		return value
+	}
+}
+
+// Const returns a function that ignores its argument and always returns result.
+// Example:
+//   - collection.Map(rows, Const[Row](0)) returns a zero for every row.
+func Const[T any, R any](result R) func(T) R {
+	return func(T) R {
+		return result
+	}
+}
+
+// Tap returns a function that runs side on its argument and then returns the argument unchanged,
+// e.g. to log values in the middle of a pipeline.
+func Tap[T any](side func(T)) func(T) T {
+	return func(value T) T {
+		side(value)
+		return value
+	}
+}
+
+// Flip swaps the parameters of a two-parameter function.
+// Example:
+//   - Curry2(Flip(strings.TrimPrefix))("v") returns a function trimming a "v" prefix.
+func Flip[T1, T2, R any](fn func(T1, T2) R) func(T2, T1) R {
+	return func(t2 T2, t1 T1) R {
+		return fn(t1, t2)
+	}
+}
@@ -0,0 +1,36 @@
+package fn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentity(t *testing.T) {
+	assert.Equal(t, 42, Identity[int]()(42))
+	assert.Equal(t, "x", Identity[string]()("x"))
+}
+
+func TestConst(t *testing.T) {
+	zero := Const[string](0)
+	assert.Equal(t, 0, zero("anything"))
+	assert.Equal(t, 0, zero(""))
+}
+
+func TestTap(t *testing.T) {
+	seen := []int{}
+	record := Tap(func(n int) { seen = append(seen, n) })
+
+	assert.Equal(t, 3, record(3))
+	assert.Equal(t, 5, record(5))
+	assert.Equal(t, []int{3, 5}, seen)
+}
+
+func TestFlip(t *testing.T) {
+	subtract := func(a, b int) int { return a - b }
+	assert.Equal(t, 3, Flip(subtract)(2, 5))
+
+	trimV := Curry2(Flip(strings.TrimPrefix))("v")
+	assert.Equal(t, "1.2.0", trimV("v1.2.0"))
+}
@@ -0,0 +1,157 @@
+// Code generated by gencurry; DO NOT EDIT.
+
+package fn
+
+// Curry2 converts a function of 2 parameters into a chain of single-parameter functions.
+func Curry2[T1, T2, R any](fn func(T1, T2) R) func(T1) func(T2) R {
+	return func(t1 T1) func(T2) R {
+		return func(t2 T2) R {
+			return fn(t1, t2)
+		}
+	}
+}
+
+// Curry3 converts a function of 3 parameters into a chain of single-parameter functions.
+func Curry3[T1, T2, T3, R any](fn func(T1, T2, T3) R) func(T1) func(T2) func(T3) R {
+	return func(t1 T1) func(T2) func(T3) R {
+		return func(t2 T2) func(T3) R {
+			return func(t3 T3) R {
+				return fn(t1, t2, t3)
+			}
+		}
+	}
+}
+
+// Curry4 converts a function of 4 parameters into a chain of single-parameter functions.
+func Curry4[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R) func(T1) func(T2) func(T3) func(T4) R {
+	return func(t1 T1) func(T2) func(T3) func(T4) R {
+		return func(t2 T2) func(T3) func(T4) R {
+			return func(t3 T3) func(T4) R {
+				return func(t4 T4) R {
+					return fn(t1, t2, t3, t4)
+				}
+			}
+		}
+	}
+}
+
+// Curry5 converts a function of 5 parameters into a chain of single-parameter functions.
+func Curry5[T1, T2, T3, T4, T5, R any](fn func(T1, T2, T3, T4, T5) R) func(T1) func(T2) func(T3) func(T4) func(T5) R {
+	return func(t1 T1) func(T2) func(T3) func(T4) func(T5) R {
+		return func(t2 T2) func(T3) func(T4) func(T5) R {
+			return func(t3 T3) func(T4) func(T5) R {
+				return func(t4 T4) func(T5) R {
+					return func(t5 T5) R {
+						return fn(t1, t2, t3, t4, t5)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Curry6 converts a function of 6 parameters into a chain of single-parameter functions.
+func Curry6[T1, T2, T3, T4, T5, T6, R any](fn func(T1, T2, T3, T4, T5, T6) R) func(T1) func(T2) func(T3) func(T4) func(T5) func(T6) R {
+	return func(t1 T1) func(T2) func(T3) func(T4) func(T5) func(T6) R {
+		return func(t2 T2) func(T3) func(T4) func(T5) func(T6) R {
+			return func(t3 T3) func(T4) func(T5) func(T6) R {
+				return func(t4 T4) func(T5) func(T6) R {
+					return func(t5 T5) func(T6) R {
+						return func(t6 T6) R {
+							return fn(t1, t2, t3, t4, t5, t6)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Curry7 converts a function of 7 parameters into a chain of single-parameter functions.
+func Curry7[T1, T2, T3, T4, T5, T6, T7, R any](fn func(T1, T2, T3, T4, T5, T6, T7) R) func(T1) func(T2) func(T3) func(T4) func(T5) func(T6) func(T7) R {
+	return func(t1 T1) func(T2) func(T3) func(T4) func(T5) func(T6) func(T7) R {
+		return func(t2 T2) func(T3) func(T4) func(T5) func(T6) func(T7) R {
+			return func(t3 T3) func(T4) func(T5) func(T6) func(T7) R {
+				return func(t4 T4) func(T5) func(T6) func(T7) R {
+					return func(t5 T5) func(T6) func(T7) R {
+						return func(t6 T6) func(T7) R {
+							return func(t7 T7) R {
+								return fn(t1, t2, t3, t4, t5, t6, t7)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Curry8 converts a function of 8 parameters into a chain of single-parameter functions.
+func Curry8[T1, T2, T3, T4, T5, T6, T7, T8, R any](fn func(T1, T2, T3, T4, T5, T6, T7, T8) R) func(T1) func(T2) func(T3) func(T4) func(T5) func(T6) func(T7) func(T8) R {
+	return func(t1 T1) func(T2) func(T3) func(T4) func(T5) func(T6) func(T7) func(T8) R {
+		return func(t2 T2) func(T3) func(T4) func(T5) func(T6) func(T7) func(T8) R {
+			return func(t3 T3) func(T4) func(T5) func(T6) func(T7) func(T8) R {
+				return func(t4 T4) func(T5) func(T6) func(T7) func(T8) R {
+					return func(t5 T5) func(T6) func(T7) func(T8) R {
+						return func(t6 T6) func(T7) func(T8) R {
+							return func(t7 T7) func(T8) R {
+								return func(t8 T8) R {
+									return fn(t1, t2, t3, t4, t5, t6, t7, t8)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry2 is the reverse of Curry2: it converts a chain of single-parameter functions into a function of 2 parameters.
+func Uncurry2[T1, T2, R any](fn func(T1) func(T2) R) func(T1, T2) R {
+	return func(t1 T1, t2 T2) R {
+		return fn(t1)(t2)
+	}
+}
+
+// Uncurry3 is the reverse of Curry3: it converts a chain of single-parameter functions into a function of 3 parameters.
+func Uncurry3[T1, T2, T3, R any](fn func(T1) func(T2) func(T3) R) func(T1, T2, T3) R {
+	return func(t1 T1, t2 T2, t3 T3) R {
+		return fn(t1)(t2)(t3)
+	}
+}
+
+// Uncurry4 is the reverse of Curry4: it converts a chain of single-parameter functions into a function of 4 parameters.
+func Uncurry4[T1, T2, T3, T4, R any](fn func(T1) func(T2) func(T3) func(T4) R) func(T1, T2, T3, T4) R {
+	return func(t1 T1, t2 T2, t3 T3, t4 T4) R {
+		return fn(t1)(t2)(t3)(t4)
+	}
+}
+
+// Uncurry5 is the reverse of Curry5: it converts a chain of single-parameter functions into a function of 5 parameters.
+func Uncurry5[T1, T2, T3, T4, T5, R any](fn func(T1) func(T2) func(T3) func(T4) func(T5) R) func(T1, T2, T3, T4, T5) R {
+	return func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5) R {
+		return fn(t1)(t2)(t3)(t4)(t5)
+	}
+}
+
+// Uncurry6 is the reverse of Curry6: it converts a chain of single-parameter functions into a function of 6 parameters.
+func Uncurry6[T1, T2, T3, T4, T5, T6, R any](fn func(T1) func(T2) func(T3) func(T4) func(T5) func(T6) R) func(T1, T2, T3, T4, T5, T6) R {
+	return func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6) R {
+		return fn(t1)(t2)(t3)(t4)(t5)(t6)
+	}
+}
+
+// Uncurry7 is the reverse of Curry7: it converts a chain of single-parameter functions into a function of 7 parameters.
+func Uncurry7[T1, T2, T3, T4, T5, T6, T7, R any](fn func(T1) func(T2) func(T3) func(T4) func(T5) func(T6) func(T7) R) func(T1, T2, T3, T4, T5, T6, T7) R {
+	return func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7) R {
+		return fn(t1)(t2)(t3)(t4)(t5)(t6)(t7)
+	}
+}
+
+// Uncurry8 is the reverse of Curry8: it converts a chain of single-parameter functions into a function of 8 parameters.
+func Uncurry8[T1, T2, T3, T4, T5, T6, T7, T8, R any](fn func(T1) func(T2) func(T3) func(T4) func(T5) func(T6) func(T7) func(T8) R) func(T1, T2, T3, T4, T5, T6, T7, T8) R {
+	return func(t1 T1, t2 T2, t3 T3, t4 T4, t5 T5, t6 T6, t7 T7, t8 T8) R {
+		return fn(t1)(t2)(t3)(t4)(t5)(t6)(t7)(t8)
+	}
+}
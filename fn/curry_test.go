@@ -0,0 +1,66 @@
+package fn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurry(t *testing.T) {
+	t.Run("Curry2", func(t *testing.T) {
+		add := Curry2(func(a, b int) int { return a + b })
+		addTen := add(10)
+
+		assert.Equal(t, 15, addTen(5))
+		assert.Equal(t, 3, add(1)(2))
+	})
+
+	t.Run("Curry3 with mixed types", func(t *testing.T) {
+		format := Curry3(func(name string, age int, active bool) string {
+			return fmt.Sprintf("%s:%d:%v", name, age, active)
+		})
+
+		assert.Equal(t, "alice:30:true", format("alice")(30)(true))
+	})
+
+	t.Run("Curry4 partial application", func(t *testing.T) {
+		report := Curry4(func(title string, year, month int, currency string) string {
+			return fmt.Sprintf("%s %d-%02d (%s)", title, year, month, currency)
+		})
+		salesIn2024 := report("Sales")(2024)
+
+		assert.Equal(t, "Sales 2024-01 (USD)", salesIn2024(1)("USD"))
+		assert.Equal(t, "Sales 2024-12 (EUR)", salesIn2024(12)("EUR"))
+	})
+
+	t.Run("Curry8", func(t *testing.T) {
+		sum := Curry8(func(a, b, c, d, e, f, g, h int) int { return a + b + c + d + e + f + g + h })
+
+		assert.Equal(t, 36, sum(1)(2)(3)(4)(5)(6)(7)(8))
+	})
+}
+
+func TestUncurry(t *testing.T) {
+	t.Run("Uncurry2", func(t *testing.T) {
+		add := Uncurry2(func(a int) func(int) int { return func(b int) int { return a + b } })
+
+		assert.Equal(t, 3, add(1, 2))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		format := func(name string, age int, active bool) string {
+			return fmt.Sprintf("%s:%d:%v", name, age, active)
+		}
+
+		assert.Equal(t, format("bob", 40, false), Uncurry3(Curry3(format))("bob", 40, false))
+	})
+
+	t.Run("Uncurry4", func(t *testing.T) {
+		report := Curry4(func(title string, year, month int, currency string) string {
+			return fmt.Sprintf("%s %d-%02d (%s)", title, year, month, currency)
+		})
+
+		assert.Equal(t, "Sales 2024-01 (USD)", Uncurry4(report)("Sales", 2024, 1, "USD"))
+	})
+}
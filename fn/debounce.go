@@ -0,0 +1,160 @@
+package fn
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounced delays a function until calls have stopped for a quiet period. It is created by
+// Debounce and is safe for concurrent use.
+type Debounced struct {
+	mu         sync.Mutex
+	wait       time.Duration
+	fn         func()
+	timer      *time.Timer
+	generation int
+	pending    bool
+	stopped    bool
+}
+
+// Debounce returns a Debounced that runs fn once wait has passed without another Call.
+// A burst of calls therefore results in a single run after the last one.
+// Example:
+//   - invalidate := Debounce(time.Second, cache.Flush); collection.ForEach(events, func(Event) { invalidate.Call() })
+func Debounce(wait time.Duration, fn func()) *Debounced {
+	return &Debounced{wait: wait, fn: fn}
+}
+
+// Call schedules fn to run after the quiet period, restarting the period if a run is already pending.
+// Calls after Stop are ignored.
+func (d *Debounced) Call() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stopped {
+		return
+	}
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	d.pending = true
+	d.generation++
+	generation := d.generation
+	d.timer = time.AfterFunc(d.wait, func() { d.fire(generation) })
+}
+
+// Flush runs a pending call immediately instead of waiting for the quiet period.
+// It does nothing when no call is pending.
+func (d *Debounced) Flush() {
+	d.mu.Lock()
+	if !d.pending {
+		d.mu.Unlock()
+		return
+	}
+	d.pending = false
+	d.timer.Stop()
+	d.mu.Unlock()
+	d.fn()
+}
+
+// Stop cancels a pending call and makes every later Call a no-op.
+func (d *Debounced) Stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stopped = true
+	d.pending = false
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+}
+
+// fire runs fn unless a later Call, Flush or Stop superseded the timer that scheduled it.
+func (d *Debounced) fire(generation int) {
+	d.mu.Lock()
+	if !d.pending || generation != d.generation {
+		d.mu.Unlock()
+		return
+	}
+	d.pending = false
+	d.mu.Unlock()
+	d.fn()
+}
+
+// Throttled runs a function at most once per interval. It is created by Throttle and is safe
+// for concurrent use.
+type Throttled struct {
+	mu       sync.Mutex
+	interval time.Duration
+	fn       func()
+	timer    *time.Timer
+	last     time.Time
+	pending  bool
+	stopped  bool
+}
+
+// Throttle returns a Throttled that runs fn at most once per interval. The first call in an
+// interval runs fn immediately; further calls in the same interval are collapsed into one
+// trailing run at the end of the interval.
+func Throttle(interval time.Duration, fn func()) *Throttled {
+	return &Throttled{interval: interval, fn: fn}
+}
+
+// Call runs fn now if the interval since the last run has passed, and otherwise schedules one
+// trailing run. Calls after Stop are ignored.
+func (t *Throttled) Call() {
+	t.mu.Lock()
+	if t.stopped {
+		t.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	elapsed := now.Sub(t.last)
+	if t.last.IsZero() || elapsed >= t.interval {
+		t.last = now
+		t.mu.Unlock()
+		t.fn()
+		return
+	}
+	if !t.pending {
+		t.pending = true
+		t.timer = time.AfterFunc(t.interval-elapsed, t.fire)
+	}
+	t.mu.Unlock()
+}
+
+// Flush runs a pending trailing call immediately. It does nothing when no call is pending.
+func (t *Throttled) Flush() {
+	t.mu.Lock()
+	if !t.pending {
+		t.mu.Unlock()
+		return
+	}
+	t.pending = false
+	t.timer.Stop()
+	t.last = time.Now()
+	t.mu.Unlock()
+	t.fn()
+}
+
+// Stop cancels a pending trailing call and makes every later Call a no-op.
+func (t *Throttled) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stopped = true
+	t.pending = false
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+}
+
+// fire runs the trailing call unless Flush or Stop already handled it.
+func (t *Throttled) fire() {
+	t.mu.Lock()
+	if !t.pending {
+		t.mu.Unlock()
+		return
+	}
+	t.pending = false
+	t.last = time.Now()
+	t.mu.Unlock()
+	t.fn()
+}
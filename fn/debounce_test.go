@@ -0,0 +1,100 @@
+package fn
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebounce(t *testing.T) {
+	t.Run("a burst runs once after the quiet period", func(t *testing.T) {
+		var runs atomic.Int32
+		d := Debounce(20*time.Millisecond, func() { runs.Add(1) })
+		for i := 0; i < 5; i++ {
+			d.Call()
+		}
+		assert.Equal(t, int32(0), runs.Load())
+		assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, 5*time.Millisecond)
+		time.Sleep(40 * time.Millisecond)
+		assert.Equal(t, int32(1), runs.Load())
+	})
+
+	t.Run("Flush runs the pending call now", func(t *testing.T) {
+		var runs atomic.Int32
+		d := Debounce(time.Hour, func() { runs.Add(1) })
+		d.Flush()
+		assert.Equal(t, int32(0), runs.Load())
+
+		d.Call()
+		d.Flush()
+		assert.Equal(t, int32(1), runs.Load())
+		d.Flush()
+		assert.Equal(t, int32(1), runs.Load())
+	})
+
+	t.Run("Stop cancels pending and later calls", func(t *testing.T) {
+		var runs atomic.Int32
+		d := Debounce(10*time.Millisecond, func() { runs.Add(1) })
+		d.Call()
+		d.Stop()
+		d.Call()
+		time.Sleep(40 * time.Millisecond)
+		assert.Equal(t, int32(0), runs.Load())
+	})
+
+	t.Run("concurrent callers", func(t *testing.T) {
+		var runs atomic.Int32
+		d := Debounce(20*time.Millisecond, func() { runs.Add(1) })
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.Call()
+			}()
+		}
+		wg.Wait()
+		assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, 5*time.Millisecond)
+	})
+}
+
+func TestThrottle(t *testing.T) {
+	t.Run("leading run and one trailing run per interval", func(t *testing.T) {
+		var runs atomic.Int32
+		th := Throttle(30*time.Millisecond, func() { runs.Add(1) })
+		for i := 0; i < 5; i++ {
+			th.Call()
+		}
+		assert.Equal(t, int32(1), runs.Load())
+		assert.Eventually(t, func() bool { return runs.Load() == 2 }, time.Second, 5*time.Millisecond)
+		time.Sleep(60 * time.Millisecond)
+		assert.Equal(t, int32(2), runs.Load())
+	})
+
+	t.Run("Flush runs the trailing call now", func(t *testing.T) {
+		var runs atomic.Int32
+		th := Throttle(time.Hour, func() { runs.Add(1) })
+		th.Call()
+		th.Call()
+		th.Call()
+		assert.Equal(t, int32(1), runs.Load())
+		th.Flush()
+		assert.Equal(t, int32(2), runs.Load())
+		th.Flush()
+		assert.Equal(t, int32(2), runs.Load())
+	})
+
+	t.Run("Stop cancels the trailing call", func(t *testing.T) {
+		var runs atomic.Int32
+		th := Throttle(10*time.Millisecond, func() { runs.Add(1) })
+		th.Call()
+		th.Call()
+		th.Stop()
+		th.Call()
+		time.Sleep(40 * time.Millisecond)
+		assert.Equal(t, int32(1), runs.Load())
+	})
+}
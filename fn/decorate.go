@@ -0,0 +1,12 @@
+package fn
+
+// Decorate applies the decorators to base in order, each wrapping the result of the previous one,
+// so the last decorator ends up outermost.
+// Example:
+//   - Decorate(client, withRetry, withLogging) returns withLogging(withRetry(client)).
+func Decorate[T any](base T, decorators ...func(T) T) T {
+	for _, decorate := range decorators {
+		base = decorate(base)
+	}
+	return base
+}
@@ -0,0 +1,22 @@
+package fn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecorate(t *testing.T) {
+	type greeter func(name string) string
+	base := greeter(func(name string) string { return "hello " + name })
+	exclaim := func(next greeter) greeter {
+		return func(name string) string { return next(name) + "!" }
+	}
+	bracket := func(next greeter) greeter {
+		return func(name string) string { return "[" + next(name) + "]" }
+	}
+
+	assert.Equal(t, "[hello bob!]", Decorate(base, exclaim, bracket)("bob"))
+	assert.Equal(t, "[hello bob]!", Decorate(base, bracket, exclaim)("bob"))
+	assert.Equal(t, "hello bob", Decorate(base)("bob"))
+}
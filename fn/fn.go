@@ -0,0 +1,5 @@
+// Package fn provides combinators for building and adapting functions.
+package fn
+
+//go:generate go run ./internal/gencurry
+//go:generate go run ./internal/genbind
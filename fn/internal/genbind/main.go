@@ -0,0 +1,81 @@
+// Command genbind generates the typed BindNAt functions of package fn.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+const maxArity = 4
+
+var arityNames = map[int]string{2: "two", 3: "three", 4: "four"}
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by genbind; DO NOT EDIT.\n\npackage fn\n")
+
+	for n := 2; n <= maxArity; n++ {
+		// Every non-empty subset of the parameters can be fixed; mask bit i fixes parameter i+1.
+		for mask := 1; mask < 1<<n; mask++ {
+			writeBind(&buf, n, mask)
+		}
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("bind_gen.go", source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeBind(buf *bytes.Buffer, n int, mask int) {
+	var params, args, positions, fixed, free, freeTypes, freePositions []string
+	for i := 1; i <= n; i++ {
+		param := fmt.Sprintf("T%d", i)
+		arg := fmt.Sprintf("t%d", i)
+		params = append(params, param)
+		args = append(args, arg)
+		if mask&(1<<(i-1)) != 0 {
+			positions = append(positions, fmt.Sprint(i))
+			fixed = append(fixed, arg+" "+param)
+		} else {
+			free = append(free, arg+" "+param)
+			freeTypes = append(freeTypes, param)
+			freePositions = append(freePositions, fmt.Sprint(i))
+		}
+	}
+
+	name := fmt.Sprintf("Bind%dAt%s", n, strings.Join(positions, ""))
+	fmt.Fprintf(buf, "\n// %s fixes parameter%s %s of a %s-parameter function.\n", name, plural(positions), list(positions), arityNames[n])
+	if len(free) == 0 {
+		buf.WriteString("// The result takes no arguments.\n")
+	} else {
+		fmt.Fprintf(buf, "// The result takes parameter%s %s.\n", plural(freePositions), list(freePositions))
+	}
+	fmt.Fprintf(buf, "func %s[%s, R any](fn func(%s) R, %s) func(%s) R {\n",
+		name, strings.Join(params, ", "), strings.Join(params, ", "), strings.Join(fixed, ", "), strings.Join(freeTypes, ", "))
+	fmt.Fprintf(buf, "return func(%s) R {\n", strings.Join(free, ", "))
+	fmt.Fprintf(buf, "return fn(%s)\n", strings.Join(args, ", "))
+	buf.WriteString("}\n}\n")
+}
+
+func plural(items []string) string {
+	if len(items) > 1 {
+		return "s"
+	}
+	return ""
+}
+
+// list joins positions as "1", "1 and 2" or "1, 2 and 3".
+func list(positions []string) string {
+	if len(positions) == 1 {
+		return positions[0]
+	}
+	return strings.Join(positions[:len(positions)-1], ", ") + " and " + positions[len(positions)-1]
+}
@@ -0,0 +1,86 @@
+// Command gencurry generates the CurryN and UncurryN functions of package fn.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+const maxArity = 8
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gencurry; DO NOT EDIT.\n\npackage fn\n")
+
+	for n := 2; n <= maxArity; n++ {
+		writeCurry(&buf, n)
+	}
+	for n := 2; n <= maxArity; n++ {
+		writeUncurry(&buf, n)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("curry_gen.go", source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// typeParams returns T1..Tn.
+func typeParams(n int) []string {
+	params := make([]string, n)
+	for i := range params {
+		params[i] = fmt.Sprintf("T%d", i+1)
+	}
+	return params
+}
+
+// curriedType returns func(T{from}) func(T{from+1}) ... R.
+func curriedType(params []string, from int) string {
+	var b strings.Builder
+	for _, param := range params[from:] {
+		fmt.Fprintf(&b, "func(%s) ", param)
+	}
+	b.WriteString("R")
+	return b.String()
+}
+
+func writeCurry(buf *bytes.Buffer, n int) {
+	params := typeParams(n)
+	args := make([]string, n)
+	for i := range args {
+		args[i] = fmt.Sprintf("t%d", i+1)
+	}
+
+	fmt.Fprintf(buf, "\n// Curry%d converts a function of %d parameters into a chain of single-parameter functions.\n", n, n)
+	fmt.Fprintf(buf, "func Curry%d[%s, R any](fn func(%s) R) %s {\n", n, strings.Join(params, ", "), strings.Join(params, ", "), curriedType(params, 0))
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(buf, "return func(%s %s) %s {\n", args[i], params[i], curriedType(params, i+1))
+	}
+	fmt.Fprintf(buf, "return fn(%s)\n", strings.Join(args, ", "))
+	buf.WriteString(strings.Repeat("}\n", n+1))
+}
+
+func writeUncurry(buf *bytes.Buffer, n int) {
+	params := typeParams(n)
+	args := make([]string, n)
+	for i := range args {
+		args[i] = fmt.Sprintf("t%d", i+1)
+	}
+	typed := make([]string, n)
+	for i := range typed {
+		typed[i] = args[i] + " " + params[i]
+	}
+
+	fmt.Fprintf(buf, "\n// Uncurry%d is the reverse of Curry%d: it converts a chain of single-parameter functions into a function of %d parameters.\n", n, n, n)
+	fmt.Fprintf(buf, "func Uncurry%d[%s, R any](fn %s) func(%s) R {\n", n, strings.Join(params, ", "), curriedType(params, 0), strings.Join(params, ", "))
+	fmt.Fprintf(buf, "return func(%s) R {\n", strings.Join(typed, ", "))
+	fmt.Fprintf(buf, "return fn(%s)\n", strings.Join(args, ")("))
+	buf.WriteString("}\n}\n")
+}
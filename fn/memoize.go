@@ -0,0 +1,116 @@
+package fn
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lumiluminousai/golang-fp-utility/cache"
+	"github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+// memo is a concurrency-safe result table shared by the Memoize variants.
+type memo[K comparable, V any] struct {
+	mu      sync.Mutex
+	entries map[K]*memoEntry[V]
+}
+
+// memoEntry holds one result. Its mutex makes concurrent callers for the same key wait for
+// the first computation instead of repeating it.
+type memoEntry[V any] struct {
+	mu    sync.Mutex
+	done  bool
+	value V
+}
+
+func newMemo[K comparable, V any]() *memo[K, V] {
+	return &memo[K, V]{entries: make(map[K]*memoEntry[V])}
+}
+
+// get returns the cached result for key, calling compute at most once per key.
+// If compute panics, the key stays uncached and the next call retries it.
+func (m *memo[K, V]) get(key K, compute func() V) V {
+	m.mu.Lock()
+	entry, ok := m.entries[key]
+	if !ok {
+		entry = &memoEntry[V]{}
+		m.entries[key] = entry
+	}
+	m.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if !entry.done {
+		entry.value = compute()
+		entry.done = true
+	}
+	return entry.value
+}
+
+// Memoize caches the results of fn by argument. It is safe for concurrent use, and fn is called
+// at most once per argument even when several goroutines request it at the same time.
+func Memoize[A comparable, R any](fn func(A) R) func(A) R {
+	return MemoizeBy(fn, func(a A) A { return a })
+}
+
+// MemoizeBy caches the results of fn under the key derived from the argument,
+// which allows memoizing functions whose argument is not comparable.
+// Example:
+//   - MemoizeBy(sum, func(xs []int) string { return fmt.Sprint(xs) })
+func MemoizeBy[A any, K comparable, R any](fn func(A) R, key func(A) K) func(A) R {
+	results := newMemo[K, R]()
+	return func(a A) R {
+		return results.get(key(a), func() R { return fn(a) })
+	}
+}
+
+// MemoizeOptions bounds the results kept by MemoizeWithOptions. The zero value keeps every result forever.
+type MemoizeOptions struct {
+	// MaxEntries caps the number of cached results; the least recently used one is evicted first.
+	// Zero means unbounded.
+	MaxEntries int
+	// TTL is how long a result is reused before fn is called again. Zero means results never expire.
+	TTL time.Duration
+	// Now returns the current time; defaults to time.Now.
+	Now func() time.Time
+}
+
+// MemoizeWithOptions caches the results of fn by argument like Memoize, but evicts results
+// according to opts so long-running services do not grow without bound. It is backed by a
+// cache.LoadingCache, so concurrent calls for the same missing argument share a single call.
+// As with Memoize, a panic in fn reaches the caller and the next call with that argument retries it.
+func MemoizeWithOptions[A comparable, R any](fn func(A) R, opts MemoizeOptions) func(A) R {
+	results := cache.New(func(_ context.Context, a A) (R, error) {
+		return fn(a), nil
+	}, cache.Options[A, R]{MaxSize: opts.MaxEntries, TTL: opts.TTL, Now: opts.Now})
+	return func(a A) R {
+		value, _ := results.Get(context.Background(), a)
+		return value
+	}
+}
+
+// Memoize2 caches the results of a two-parameter function, keyed by a Pair of its arguments.
+func Memoize2[A comparable, B comparable, R any](fn func(A, B) R) func(A, B) R {
+	return Memoize2By(fn, tuple.NewPair[A, B])
+}
+
+// Memoize2By caches the results of a two-parameter function under the key derived from its arguments.
+func Memoize2By[A any, B any, K comparable, R any](fn func(A, B) R, key func(A, B) K) func(A, B) R {
+	results := newMemo[K, R]()
+	return func(a A, b B) R {
+		return results.get(key(a, b), func() R { return fn(a, b) })
+	}
+}
+
+// Memoize3 caches the results of a three-parameter function, keyed by a Triple of its arguments.
+func Memoize3[A comparable, B comparable, C comparable, R any](fn func(A, B, C) R) func(A, B, C) R {
+	return Memoize3By(fn, tuple.NewTriple[A, B, C])
+}
+
+// Memoize3By caches the results of a three-parameter function under the key derived from its arguments.
+func Memoize3By[A any, B any, C any, K comparable, R any](fn func(A, B, C) R, key func(A, B, C) K) func(A, B, C) R {
+	results := newMemo[K, R]()
+	return func(a A, b B, c C) R {
+		return results.get(key(a, b, c), func() R { return fn(a, b, c) })
+	}
+}
@@ -0,0 +1,200 @@
+package fn
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoize(t *testing.T) {
+	t.Run("caches by argument", func(t *testing.T) {
+		calls := 0
+		square := Memoize(func(n int) int {
+			calls++
+			return n * n
+		})
+		assert.Equal(t, 9, square(3))
+		assert.Equal(t, 9, square(3))
+		assert.Equal(t, 16, square(4))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("MemoizeBy with non-comparable argument", func(t *testing.T) {
+		calls := 0
+		sum := MemoizeBy(func(xs []int) int {
+			calls++
+			total := 0
+			for _, x := range xs {
+				total += x
+			}
+			return total
+		}, func(xs []int) string { return fmt.Sprint(xs) })
+		assert.Equal(t, 6, sum([]int{1, 2, 3}))
+		assert.Equal(t, 6, sum([]int{1, 2, 3}))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("concurrent callers", func(t *testing.T) {
+		double := Memoize(func(n int) int { return n * 2 })
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(n int) {
+				defer wg.Done()
+				assert.Equal(t, (n%5)*2, double(n%5))
+			}(i)
+		}
+		wg.Wait()
+	})
+
+	t.Run("concurrent callers share one call per argument", func(t *testing.T) {
+		var calls atomic.Int32
+		release := make(chan struct{})
+		slow := Memoize(func(n int) int {
+			calls.Add(1)
+			<-release
+			return n + 1
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Equal(t, 8, slow(7))
+			}()
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("panicking call is retried", func(t *testing.T) {
+		calls := 0
+		flaky := Memoize(func(n int) int {
+			calls++
+			if calls == 1 {
+				panic("boom")
+			}
+			return n
+		})
+		assert.Panics(t, func() { flaky(1) })
+		assert.Equal(t, 1, flaky(1))
+		assert.Equal(t, 1, flaky(1))
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestMemoizeWithOptions(t *testing.T) {
+	t.Run("LRU eviction", func(t *testing.T) {
+		calls := map[int]int{}
+		square := MemoizeWithOptions(func(n int) int {
+			calls[n]++
+			return n * n
+		}, MemoizeOptions{MaxEntries: 2})
+
+		square(1)
+		square(2)
+		square(1) // 1 is now most recently used
+		square(3) // evicts 2
+		assert.Equal(t, 4, square(2))
+		assert.Equal(t, 9, square(3))
+		assert.Equal(t, map[int]int{1: 1, 2: 2, 3: 1}, calls)
+	})
+
+	t.Run("TTL expiry", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		calls := 0
+		lookup := MemoizeWithOptions(func(key string) string {
+			calls++
+			return key + "!"
+		}, MemoizeOptions{TTL: time.Minute, Now: func() time.Time { return now }})
+
+		assert.Equal(t, "a!", lookup("a"))
+		now = now.Add(30 * time.Second)
+		assert.Equal(t, "a!", lookup("a"))
+		assert.Equal(t, 1, calls)
+
+		now = now.Add(time.Minute)
+		assert.Equal(t, "a!", lookup("a"))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("zero options keep every result", func(t *testing.T) {
+		calls := 0
+		double := MemoizeWithOptions(func(n int) int {
+			calls++
+			return n * 2
+		}, MemoizeOptions{})
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, 4, double(2))
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("panicking call is retried", func(t *testing.T) {
+		calls := 0
+		flaky := MemoizeWithOptions(func(n int) int {
+			calls++
+			if calls == 1 {
+				panic("boom")
+			}
+			return n
+		}, MemoizeOptions{MaxEntries: 10})
+		assert.PanicsWithValue(t, "boom", func() { flaky(1) })
+		assert.Equal(t, 1, flaky(1))
+		assert.Equal(t, 1, flaky(1))
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestMemoize2(t *testing.T) {
+	calls := 0
+	add := Memoize2(func(a int, b string) string {
+		calls++
+		return fmt.Sprintf("%d%s", a, b)
+	})
+	assert.Equal(t, "1a", add(1, "a"))
+	assert.Equal(t, "1a", add(1, "a"))
+	assert.Equal(t, "1b", add(1, "b"))
+	assert.Equal(t, 2, calls)
+
+	t.Run("Memoize2By", func(t *testing.T) {
+		calls := 0
+		count := Memoize2By(func(xs []int, n int) int {
+			calls++
+			return len(xs) * n
+		}, func(xs []int, n int) string { return fmt.Sprint(xs, n) })
+		assert.Equal(t, 4, count([]int{1, 2}, 2))
+		assert.Equal(t, 4, count([]int{1, 2}, 2))
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestMemoize3(t *testing.T) {
+	calls := 0
+	sum := Memoize3(func(a, b, c int) int {
+		calls++
+		return a + b + c
+	})
+	assert.Equal(t, 6, sum(1, 2, 3))
+	assert.Equal(t, 6, sum(1, 2, 3))
+	assert.Equal(t, 6, sum(3, 2, 1))
+	assert.Equal(t, 2, calls)
+
+	t.Run("Memoize3By", func(t *testing.T) {
+		calls := 0
+		f := Memoize3By(func(a, b, c int) int {
+			calls++
+			return a + b + c
+		}, func(a, b, c int) int { return a + b + c })
+		assert.Equal(t, 6, f(1, 2, 3))
+		assert.Equal(t, 6, f(3, 2, 1))
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,48 @@
+package fn
+
+// Partial2 fixes the first parameter of a two-parameter function.
+// It is Bind2At1 under its conventional name; unlike Curry2 the remaining parameters are applied together.
+// Example:
+//   - Partial2(strings.Split, "a,b")(",") returns [a b].
+func Partial2[T1, T2, R any](fn func(T1, T2) R, t1 T1) func(T2) R {
+	return func(t2 T2) R {
+		return fn(t1, t2)
+	}
+}
+
+// Partial3 fixes the first parameter of a three-parameter function.
+func Partial3[T1, T2, T3, R any](fn func(T1, T2, T3) R, t1 T1) func(T2, T3) R {
+	return func(t2 T2, t3 T3) R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// Partial4 fixes the first parameter of a four-parameter function.
+func Partial4[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t1 T1) func(T2, T3, T4) R {
+	return func(t2 T2, t3 T3, t4 T4) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
+
+// PartialRight2 fixes the last parameter of a two-parameter function.
+// Example:
+//   - PartialRight2(strings.Repeat, 3)("ab") returns "ababab".
+func PartialRight2[T1, T2, R any](fn func(T1, T2) R, t2 T2) func(T1) R {
+	return func(t1 T1) R {
+		return fn(t1, t2)
+	}
+}
+
+// PartialRight3 fixes the last parameter of a three-parameter function.
+func PartialRight3[T1, T2, T3, R any](fn func(T1, T2, T3) R, t3 T3) func(T1, T2) R {
+	return func(t1 T1, t2 T2) R {
+		return fn(t1, t2, t3)
+	}
+}
+
+// PartialRight4 fixes the last parameter of a four-parameter function.
+func PartialRight4[T1, T2, T3, T4, R any](fn func(T1, T2, T3, T4) R, t4 T4) func(T1, T2, T3) R {
+	return func(t1 T1, t2 T2, t3 T3) R {
+		return fn(t1, t2, t3, t4)
+	}
+}
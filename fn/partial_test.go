@@ -0,0 +1,36 @@
+package fn
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartial(t *testing.T) {
+	report := func(title string, year, month int, currency string) string {
+		return fmt.Sprintf("%s %d-%02d (%s)", title, year, month, currency)
+	}
+
+	t.Run("Partial2", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b"}, Partial2(strings.Split, "a,b")(","))
+	})
+
+	t.Run("Partial3", func(t *testing.T) {
+		replaceInDoc := Partial3(func(s, old, new string) string { return strings.ReplaceAll(s, old, new) }, "a-b-c")
+		assert.Equal(t, "a+b+c", replaceInDoc("-", "+"))
+	})
+
+	t.Run("Partial4 pins two leading parameters when chained", func(t *testing.T) {
+		sales := Partial3(Partial4(report, "Sales"), 2024)
+		assert.Equal(t, "Sales 2024-03 (EUR)", sales(3, "EUR"))
+	})
+
+	t.Run("PartialRight", func(t *testing.T) {
+		assert.Equal(t, "ababab", PartialRight2(strings.Repeat, 3)("ab"))
+		assert.Equal(t, "a+b-c", PartialRight4(strings.Replace, 1)("a-b-c", "-", "+"))
+		assert.Equal(t, "a:b:c", PartialRight3(strings.ReplaceAll, ":")("a-b-c", "-"))
+		assert.Equal(t, "Sales 2024-01 (USD)", PartialRight4(report, "USD")("Sales", 2024, 1))
+	})
+}
@@ -0,0 +1,31 @@
+package fn
+
+import tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+
+// Spread2 adapts a two-parameter function to accept a Pair, e.g. inside Map over zipped values.
+func Spread2[A any, B any, R any](fn func(A, B) R) func(tuple.Pair[A, B]) R {
+	return func(pair tuple.Pair[A, B]) R {
+		return fn(pair.First, pair.Second)
+	}
+}
+
+// Spread3 adapts a three-parameter function to accept a Triple.
+func Spread3[A any, B any, C any, R any](fn func(A, B, C) R) func(tuple.Triple[A, B, C]) R {
+	return func(triple tuple.Triple[A, B, C]) R {
+		return fn(triple.First, triple.Second, triple.Third)
+	}
+}
+
+// Gather2 is the reverse of Spread2: it adapts a function of a Pair to accept two parameters.
+func Gather2[A any, B any, R any](fn func(tuple.Pair[A, B]) R) func(A, B) R {
+	return func(a A, b B) R {
+		return fn(tuple.NewPair(a, b))
+	}
+}
+
+// Gather3 is the reverse of Spread3: it adapts a function of a Triple to accept three parameters.
+func Gather3[A any, B any, C any, R any](fn func(tuple.Triple[A, B, C]) R) func(A, B, C) R {
+	return func(a A, b B, c C) R {
+		return fn(tuple.NewTriple(a, b, c))
+	}
+}
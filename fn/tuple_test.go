@@ -0,0 +1,39 @@
+package fn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+func TestSpread(t *testing.T) {
+	t.Run("Spread2 inside Map", func(t *testing.T) {
+		pairs := []tuple.Pair[string, int]{tuple.NewPair("a", 2), tuple.NewPair("b", 3)}
+
+		result := collection.Map(pairs, Spread2(strings.Repeat))
+
+		assert.Equal(t, []string{"aa", "bbb"}, result)
+	})
+
+	t.Run("Spread3", func(t *testing.T) {
+		volume := Spread3(func(w, h, d int) int { return w * h * d })
+		assert.Equal(t, 24, volume(tuple.NewTriple(2, 3, 4)))
+	})
+}
+
+func TestGather(t *testing.T) {
+	t.Run("Gather2", func(t *testing.T) {
+		describe := Gather2(func(p tuple.Pair[string, int]) string { return strings.Repeat(p.First, p.Second) })
+		assert.Equal(t, "xxx", describe("x", 3))
+	})
+
+	t.Run("Gather3 reverses Spread3", func(t *testing.T) {
+		sum := func(a, b, c int) int { return a + b + c }
+		roundTrip := Gather3(Spread3(sum))
+		assert.Equal(t, sum(1, 2, 3), roundTrip(1, 2, 3))
+	})
+}
@@ -0,0 +1,149 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+// outcome is a settled future result tagged with its position.
+type outcome[T any] struct {
+	index int
+	value T
+	err   error
+}
+
+// settle waits for every future in the background and reports each result on the returned channel.
+func settle[T any](ctx context.Context, futures []Future[T]) <-chan outcome[T] {
+	results := make(chan outcome[T], len(futures))
+	for idx, f := range futures {
+		go func(idx int, f Future[T]) {
+			value, err := f.Await(ctx)
+			results <- outcome[T]{index: idx, value: value, err: err}
+		}(idx, f)
+	}
+	return results
+}
+
+func cancelAll[T any](futures []Future[T]) {
+	for _, f := range futures {
+		f.Cancel()
+	}
+}
+
+// Race returns the first successful result and cancels the computations of the others, so a
+// fast failure does not defeat hedged requests. When every future fails it returns the first
+// error, where Any joins all of them.
+func Race[T any](ctx context.Context, futures ...Future[T]) (T, error) {
+	var zero T
+	if len(futures) == 0 {
+		return zero, errors.New("future: Race requires at least one future")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer cancelAll(futures)
+
+	results := settle(ctx, futures)
+	var firstErr error
+	for range futures {
+		select {
+		case result := <-results:
+			if result.err == nil {
+				return result.value, nil
+			}
+			if firstErr == nil {
+				firstErr = result.err
+			}
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+	return zero, firstErr
+}
+
+// Any returns the first successful result and cancels the computations of the others.
+// Failures are ignored until every future has failed, in which case all errors are joined.
+func Any[T any](ctx context.Context, futures ...Future[T]) (T, error) {
+	var zero T
+	if len(futures) == 0 {
+		return zero, errors.New("future: Any requires at least one future")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer cancelAll(futures)
+
+	results := settle(ctx, futures)
+	errs := make([]error, len(futures))
+	for range futures {
+		select {
+		case result := <-results:
+			if result.err == nil {
+				return result.value, nil
+			}
+			errs[result.index] = fmt.Errorf("future %d: %w", result.index, result.err)
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+	return zero, errors.Join(errs...)
+}
+
+// All returns a Future of every result in order. It fails fast with the first error,
+// cancelling the computations of the remaining futures.
+func All[T any](futures ...Future[T]) Future[[]T] {
+	return start(context.Background(), func() { cancelAll(futures) }, func(ctx context.Context) ([]T, error) {
+		values := make([]T, len(futures))
+		results := settle(ctx, futures)
+		for range futures {
+			select {
+			case result := <-results:
+				if result.err != nil {
+					cancelAll(futures)
+					return nil, fmt.Errorf("future %d: %w", result.index, result.err)
+				}
+				values[result.index] = result.value
+			case <-ctx.Done():
+				cancelAll(futures)
+				return nil, ctx.Err()
+			}
+		}
+		return values, nil
+	})
+}
+
+// Join2 combines two futures of different types into a Future of a Pair, failing fast like All.
+func Join2[A any, B any](first Future[A], second Future[B]) Future[tuple.Pair[A, B]] {
+	return Map(All(erase(first), erase(second)), func(ctx context.Context, values []any) tuple.Pair[A, B] {
+		return tuple.NewPair(values[0].(A), values[1].(B))
+	})
+}
+
+// Join3 combines three futures of different types into a Future of a Triple, failing fast like All.
+func Join3[A any, B any, C any](first Future[A], second Future[B], third Future[C]) Future[tuple.Triple[A, B, C]] {
+	return Map(All(erase(first), erase(second), erase(third)), func(ctx context.Context, values []any) tuple.Triple[A, B, C] {
+		return tuple.NewTriple(values[0].(A), values[1].(B), values[2].(C))
+	})
+}
+
+// Zip combines two futures into a Future of a Pair, like collection.Zip for slices. It is Join2 under another name.
+func Zip[A any, B any](first Future[A], second Future[B]) Future[tuple.Pair[A, B]] {
+	return Join2(first, second)
+}
+
+// ZipWith combines the results of two futures with combine once both succeed, failing fast like All.
+// Example:
+//   - ZipWith(Async(loadUser), Async(loadOrders), buildProfile)
+func ZipWith[A any, B any, C any](first Future[A], second Future[B], combine func(a A, b B) C) Future[C] {
+	return Map(Join2(first, second), func(ctx context.Context, pair tuple.Pair[A, B]) C {
+		return combine(pair.First, pair.Second)
+	})
+}
+
+// erase converts a Future to Future[any]; cancelling the result cancels the source.
+func erase[T any](source Future[T]) Future[any] {
+	return Map(source, func(ctx context.Context, value T) any {
+		return value
+	})
+}
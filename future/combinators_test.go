@@ -0,0 +1,217 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+// delayed returns a future completing with value and err after d, or with ctx.Err() when cancelled first.
+func delayed[T any](d time.Duration, value T, err error) (Future[T], <-chan struct{}) {
+	cancelled := make(chan struct{})
+	f := Go(context.Background(), func(ctx context.Context) (T, error) {
+		select {
+		case <-time.After(d):
+			return value, err
+		case <-ctx.Done():
+			close(cancelled)
+			var zero T
+			return zero, ctx.Err()
+		}
+	})
+	return f, cancelled
+}
+
+func TestGo(t *testing.T) {
+	value, err := Go(context.Background(), func(ctx context.Context) (int, error) { return 7, nil }).Await(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, value)
+}
+
+func TestRace(t *testing.T) {
+	t.Run("first success wins and the rest are cancelled", func(t *testing.T) {
+		fast, _ := delayed(time.Millisecond, "fast", nil)
+		slow, slowCancelled := delayed(time.Second, "slow", nil)
+
+		value, err := Race(context.Background(), fast, slow)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "fast", value)
+		select {
+		case <-slowCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("slow future was not cancelled")
+		}
+	})
+
+	t.Run("a fast failure does not beat a slower success", func(t *testing.T) {
+		failing, _ := delayed(time.Millisecond, "", errors.New("boom"))
+		slow, _ := delayed(20*time.Millisecond, "slow", nil)
+
+		value, err := Race(context.Background(), failing, slow)
+		assert.NoError(t, err)
+		assert.Equal(t, "slow", value)
+	})
+
+	t.Run("first error when every future fails", func(t *testing.T) {
+		first, _ := delayed(time.Millisecond, "", errors.New("first"))
+		second, _ := delayed(10*time.Millisecond, "", errors.New("second"))
+
+		_, err := Race(context.Background(), second, first)
+		assert.EqualError(t, err, "first")
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		slow, _ := delayed(time.Second, 1, nil)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+
+		_, err := Race(ctx, slow)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("no futures", func(t *testing.T) {
+		_, err := Race[int](context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestAny(t *testing.T) {
+	t.Run("ignores failures until a success", func(t *testing.T) {
+		failing, _ := delayed(time.Millisecond, 0, errors.New("boom"))
+		ok, _ := delayed(10*time.Millisecond, 42, nil)
+
+		value, err := Any(context.Background(), failing, ok)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 42, value)
+	})
+
+	t.Run("joins errors when all fail", func(t *testing.T) {
+		first, _ := delayed(time.Millisecond, 0, errors.New("first"))
+		second, _ := delayed(2*time.Millisecond, 0, errors.New("second"))
+
+		_, err := Any(context.Background(), first, second)
+		assert.EqualError(t, err, "future 0: first\nfuture 1: second")
+	})
+
+	t.Run("works with manually completed futures", func(t *testing.T) {
+		f, complete := New[string]()
+		complete("done", nil)
+
+		value, err := Any(context.Background(), f)
+		assert.NoError(t, err)
+		assert.Equal(t, "done", value)
+	})
+}
+
+func TestAll(t *testing.T) {
+	t.Run("collects results in order", func(t *testing.T) {
+		slow, _ := delayed(10*time.Millisecond, 1, nil)
+		fast, _ := delayed(time.Millisecond, 2, nil)
+
+		values, err := All(slow, fast).Await(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, values)
+	})
+
+	t.Run("fails fast and cancels the rest", func(t *testing.T) {
+		failing, _ := delayed(time.Millisecond, 0, errors.New("boom"))
+		slow, slowCancelled := delayed(time.Second, 1, nil)
+
+		_, err := All(failing, slow).Await(context.Background())
+
+		assert.EqualError(t, err, "future 0: boom")
+		select {
+		case <-slowCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("slow future was not cancelled")
+		}
+	})
+
+	t.Run("no futures", func(t *testing.T) {
+		values, err := All[int]().Await(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, values)
+	})
+}
+
+func TestJoin(t *testing.T) {
+	t.Run("Join2 combines heterogeneous results", func(t *testing.T) {
+		name, _ := delayed(time.Millisecond, "alice", nil)
+		age, _ := delayed(2*time.Millisecond, 30, nil)
+
+		pair, err := Join2(name, age).Await(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, tuple.NewPair("alice", 30), pair)
+	})
+
+	t.Run("Join3 fails fast", func(t *testing.T) {
+		name, _ := delayed(time.Millisecond, "alice", nil)
+		failing, _ := delayed(time.Millisecond, 0, errors.New("boom"))
+		slow, slowCancelled := delayed(time.Second, true, nil)
+
+		_, err := Join3(name, failing, slow).Await(context.Background())
+
+		assert.ErrorContains(t, err, "boom")
+		select {
+		case <-slowCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("slow future was not cancelled")
+		}
+	})
+}
+
+func TestAsync(t *testing.T) {
+	value, err := Async(func() (string, error) { return "done", nil }).Await(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "done", value)
+
+	_, err = Async(func() (int, error) { return 0, errors.New("boom") }).Await(context.Background())
+	assert.EqualError(t, err, "boom")
+}
+
+func TestZip(t *testing.T) {
+	t.Run("Zip pairs the results", func(t *testing.T) {
+		pair, err := Zip(Async(func() (string, error) { return "alice", nil }), Async(func() (int, error) { return 30, nil })).
+			Await(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, tuple.NewPair("alice", 30), pair)
+	})
+
+	t.Run("ZipWith combines the results", func(t *testing.T) {
+		name, _ := delayed(time.Millisecond, "alice", nil)
+		orders, _ := delayed(2*time.Millisecond, 3, nil)
+
+		summary, err := ZipWith(name, orders, func(n string, count int) string {
+			return n + " has " + strconv.Itoa(count) + " orders"
+		}).Await(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "alice has 3 orders", summary)
+	})
+
+	t.Run("ZipWith fails fast", func(t *testing.T) {
+		failing, _ := delayed(time.Millisecond, "", errors.New("boom"))
+		slow, slowCancelled := delayed(time.Second, 1, nil)
+
+		_, err := ZipWith(failing, slow, func(string, int) int { return 0 }).Await(context.Background())
+
+		assert.ErrorContains(t, err, "boom")
+		select {
+		case <-slowCancelled:
+		case <-time.After(time.Second):
+			t.Fatal("slow future was not cancelled")
+		}
+	})
+}
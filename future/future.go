@@ -0,0 +1,121 @@
+// Package future provides a Future type representing the result of an asynchronous computation.
+package future
+
+import (
+	"context"
+	"sync"
+)
+
+// Future is a handle to a value that becomes available later.
+// Copies of a Future refer to the same underlying result.
+type Future[T any] struct {
+	state *state[T]
+}
+
+type state[T any] struct {
+	once   sync.Once
+	done   chan struct{}
+	value  T
+	err    error
+	ctx    context.Context // originating context, inherited by continuations
+	cancel func()          // stops the running computation and its upstream futures
+}
+
+func newState[T any](ctx context.Context) *state[T] {
+	return &state[T]{done: make(chan struct{}), ctx: ctx, cancel: func() {}}
+}
+
+func (s *state[T]) complete(value T, err error) {
+	s.once.Do(func() {
+		s.value = value
+		s.err = err
+		close(s.done)
+	})
+}
+
+// New returns a pending Future and the function that completes it.
+// Only the first call to complete has an effect.
+func New[T any]() (Future[T], func(value T, err error)) {
+	s := newState[T](context.Background())
+	return Future[T]{state: s}, s.complete
+}
+
+// Go runs fn in a new goroutine and returns a Future for its result.
+// fn receives a context derived from ctx that is cancelled when the Future is cancelled,
+// for example explicitly through Cancel or when it loses a Race.
+func Go[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) Future[T] {
+	return start(ctx, func() {}, fn)
+}
+
+// Async runs fn in a new goroutine and returns a Future for its result. It is Go for functions
+// that do not take a context; cancelling the Future completes it but cannot stop fn.
+// Example:
+//   - user, orders := Async(loadUser), Async(loadOrders)
+func Async[T any](fn func() (T, error)) Future[T] {
+	return Go(context.Background(), func(context.Context) (T, error) {
+		return fn()
+	})
+}
+
+// start runs fn asynchronously; cancelling the resulting Future also calls upstream.
+func start[T any](ctx context.Context, upstream func(), fn func(ctx context.Context) (T, error)) Future[T] {
+	s := newState[T](ctx)
+	runCtx, cancel := context.WithCancel(ctx)
+	s.cancel = func() {
+		cancel()
+		upstream()
+	}
+	go func() {
+		defer cancel()
+		s.complete(fn(runCtx))
+	}()
+	return Future[T]{state: s}
+}
+
+// Then schedules fn to run with the originating context once source succeeds.
+// The stage is skipped when source fails or the originating context is cancelled;
+// cancelling the returned Future also cancels source.
+func Then[T1 any, T2 any](source Future[T1], fn func(ctx context.Context, value T1) (T2, error)) Future[T2] {
+	return start(source.state.ctx, source.Cancel, func(ctx context.Context) (T2, error) {
+		var zero T2
+		value, err := source.Await(ctx)
+		if err != nil {
+			return zero, err
+		}
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		return fn(ctx, value)
+	})
+}
+
+// Map transforms the result of source once it succeeds, like Then for infallible functions.
+func Map[T1 any, T2 any](source Future[T1], fn func(ctx context.Context, value T1) T2) Future[T2] {
+	return Then(source, func(ctx context.Context, value T1) (T2, error) {
+		return fn(ctx, value), nil
+	})
+}
+
+// Cancel stops the computation behind the Future and its upstream stages.
+// A Future that has not completed yet completes with context.Canceled.
+func (f Future[T]) Cancel() {
+	f.state.cancel()
+	var zero T
+	f.state.complete(zero, context.Canceled)
+}
+
+// Done returns a channel that is closed once the Future is completed.
+func (f Future[T]) Done() <-chan struct{} {
+	return f.state.done
+}
+
+// Await blocks until the Future is completed or ctx is done.
+func (f Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.state.done:
+		return f.state.value, f.state.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,151 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("completes once", func(t *testing.T) {
+		f, complete := New[int]()
+		complete(1, nil)
+		complete(2, errors.New("ignored"))
+
+		value, err := f.Await(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, value)
+	})
+
+	t.Run("done channel closes on completion", func(t *testing.T) {
+		f, complete := New[string]()
+		select {
+		case <-f.Done():
+			t.Fatal("future should be pending")
+		default:
+		}
+
+		complete("", errors.New("boom"))
+		<-f.Done()
+		_, err := f.Await(context.Background())
+		assert.EqualError(t, err, "boom")
+	})
+
+	t.Run("await honours context", func(t *testing.T) {
+		f, _ := New[int]()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+
+		_, err := f.Await(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestThenAndMap(t *testing.T) {
+	t.Run("chains stages", func(t *testing.T) {
+		f := Go(context.Background(), func(ctx context.Context) (int, error) { return 2, nil })
+		doubled := Map(f, func(ctx context.Context, value int) int { return value * 2 })
+		text := Then(doubled, func(ctx context.Context, value int) (string, error) {
+			return strings.Repeat("x", value), nil
+		})
+
+		value, err := text.Await(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "xxxx", value)
+	})
+
+	t.Run("continuations receive the originating context", func(t *testing.T) {
+		type key struct{}
+		ctx := context.WithValue(context.Background(), key{}, "request-1")
+		f := Go(ctx, func(ctx context.Context) (int, error) { return 1, nil })
+
+		value, err := Map(f, func(ctx context.Context, value int) string {
+			return ctx.Value(key{}).(string)
+		}).Await(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "request-1", value)
+	})
+
+	t.Run("errors skip later stages", func(t *testing.T) {
+		called := false
+		f := Go(context.Background(), func(ctx context.Context) (int, error) { return 0, errors.New("boom") })
+		_, err := Map(f, func(ctx context.Context, value int) int {
+			called = true
+			return value
+		}).Await(context.Background())
+
+		assert.EqualError(t, err, "boom")
+		assert.False(t, called)
+	})
+
+	t.Run("cancelled origin stops scheduling", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		release := make(chan struct{})
+		f := Go(ctx, func(context.Context) (int, error) {
+			<-release
+			return 1, nil
+		})
+		called := false
+		next := Map(f, func(ctx context.Context, value int) int {
+			called = true
+			return value
+		})
+
+		cancel()
+		close(release)
+		_, err := next.Await(context.Background())
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, called)
+	})
+}
+
+func TestCancel(t *testing.T) {
+	t.Run("cancels the computation and completes the future", func(t *testing.T) {
+		stopped := make(chan struct{})
+		f := Go(context.Background(), func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			close(stopped)
+			return 0, ctx.Err()
+		})
+
+		f.Cancel()
+		_, err := f.Await(context.Background())
+
+		assert.ErrorIs(t, err, context.Canceled)
+		<-stopped
+	})
+
+	t.Run("cancelling a continuation cancels upstream", func(t *testing.T) {
+		stopped := make(chan struct{})
+		f := Go(context.Background(), func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			close(stopped)
+			return 0, ctx.Err()
+		})
+		next := Map(f, func(ctx context.Context, value int) int { return value })
+
+		next.Cancel()
+
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("upstream was not cancelled")
+		}
+	})
+
+	t.Run("completed futures keep their result", func(t *testing.T) {
+		f, complete := New[int]()
+		complete(3, nil)
+		f.Cancel()
+
+		value, err := f.Await(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, value)
+	})
+}
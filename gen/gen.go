@@ -0,0 +1,382 @@
+// Package gen provides composable random value generators and a property runner with shrinking.
+package gen
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Arbitrary generates random values of type T together with their shrink candidates.
+// Arbitraries are immutable values and can be freely shared and combined.
+type Arbitrary[T any] struct {
+	sample func(r *rand.Rand, size int) tree[T]
+}
+
+// tree is a lazily evaluated rose tree: a generated value and the smaller values it shrinks to.
+type tree[T any] struct {
+	value   T
+	shrinks func() []tree[T]
+}
+
+func leaf[T any](value T) tree[T] {
+	return tree[T]{value: value, shrinks: func() []tree[T] { return nil }}
+}
+
+func mapTree[T1 any, T2 any](source tree[T1], transform func(T1) T2) tree[T2] {
+	return tree[T2]{
+		value: transform(source.value),
+		shrinks: func() []tree[T2] {
+			children := source.shrinks()
+			result := make([]tree[T2], 0, len(children))
+			for _, child := range children {
+				result = append(result, mapTree(child, transform))
+			}
+			return result
+		},
+	}
+}
+
+func filterTree[T any](source tree[T], predicate func(T) bool) tree[T] {
+	return tree[T]{
+		value: source.value,
+		shrinks: func() []tree[T] {
+			result := []tree[T]{}
+			for _, child := range source.shrinks() {
+				if predicate(child.value) {
+					result = append(result, filterTree(child, predicate))
+				}
+			}
+			return result
+		},
+	}
+}
+
+// Sample draws a single value from the arbitrary.
+func (a Arbitrary[T]) Sample(r *rand.Rand, size int) T {
+	return a.sample(r, size).value
+}
+
+// Const always generates the given value.
+func Const[T any](value T) Arbitrary[T] {
+	return Arbitrary[T]{sample: func(*rand.Rand, int) tree[T] { return leaf(value) }}
+}
+
+// Int generates integers in the inclusive range [min, max], shrinking towards zero
+// (or towards the bound closest to zero when zero is out of range).
+func Int(min, max int) Arbitrary[int] {
+	if min > max {
+		panic(fmt.Sprintf("gen: Int min %d is greater than max %d", min, max))
+	}
+	origin := 0
+	if origin < min {
+		origin = min
+	} else if origin > max {
+		origin = max
+	}
+	// The span is computed in uint64 so ranges wider than math.MaxInt64 do not overflow;
+	// adding the offset back wraps around to the right int.
+	span := uint64(max) - uint64(min)
+	return Arbitrary[int]{sample: func(r *rand.Rand, size int) tree[int] {
+		value := int(uint64(min) + uniformUint64(r, span))
+		return intTree(value, origin)
+	}}
+}
+
+// uniformUint64 returns a uniformly distributed value in [0, max].
+func uniformUint64(r *rand.Rand, max uint64) uint64 {
+	switch {
+	case max == math.MaxUint64:
+		return r.Uint64()
+	case max < math.MaxInt64:
+		return uint64(r.Int63n(int64(max) + 1))
+	}
+	// More than half of all uint64 values are in range, so rejection sampling ends quickly.
+	for {
+		if value := r.Uint64(); value <= max {
+			return value
+		}
+	}
+}
+
+func intTree(value, origin int) tree[int] {
+	return tree[int]{
+		value: value,
+		shrinks: func() []tree[int] {
+			result := []tree[int]{}
+			for distance := value - origin; distance != 0; distance /= 2 {
+				result = append(result, intTree(value-distance, origin))
+			}
+			return result
+		},
+	}
+}
+
+// Bool generates booleans, shrinking towards false.
+func Bool() Arbitrary[bool] {
+	return Map(Int(0, 1), func(value int) bool { return value == 1 })
+}
+
+// String generates printable ASCII strings whose length grows with the size parameter.
+func String() Arbitrary[string] {
+	runes := Map(Int(' ', '~'), func(value int) rune { return rune(value) })
+	return Map(SliceOf(runes), func(value []rune) string { return string(value) })
+}
+
+// SliceOf generates slices of up to size elements.
+// Shrinking removes elements first and then shrinks the remaining ones.
+func SliceOf[T any](element Arbitrary[T]) Arbitrary[[]T] {
+	return Arbitrary[[]T]{sample: func(r *rand.Rand, size int) tree[[]T] {
+		length := 0
+		if size > 0 {
+			length = r.Intn(size + 1)
+		}
+		elements := make([]tree[T], length)
+		for i := range elements {
+			elements[i] = element.sample(r, size)
+		}
+		return sliceTree(elements)
+	}}
+}
+
+func sliceTree[T any](elements []tree[T]) tree[[]T] {
+	return tree[[]T]{
+		value: treeValues(elements),
+		shrinks: func() []tree[[]T] {
+			result := []tree[[]T]{}
+			for i := range elements {
+				removed := make([]tree[T], 0, len(elements)-1)
+				removed = append(removed, elements[:i]...)
+				removed = append(removed, elements[i+1:]...)
+				result = append(result, sliceTree(removed))
+			}
+			return append(result, shrinkEach(elements, sliceTree[T])...)
+		},
+	}
+}
+
+// productTree combines a fixed number of trees; unlike sliceTree it never removes elements.
+func productTree[T any](elements []tree[T]) tree[[]T] {
+	return tree[[]T]{
+		value: treeValues(elements),
+		shrinks: func() []tree[[]T] {
+			return shrinkEach(elements, productTree[T])
+		},
+	}
+}
+
+// shrinkEach shrinks one element at a time, rebuilding the combined tree with build.
+func shrinkEach[T any](elements []tree[T], build func([]tree[T]) tree[[]T]) []tree[[]T] {
+	result := []tree[[]T]{}
+	for i := range elements {
+		for _, child := range elements[i].shrinks() {
+			replaced := make([]tree[T], len(elements))
+			copy(replaced, elements)
+			replaced[i] = child
+			result = append(result, build(replaced))
+		}
+	}
+	return result
+}
+
+func treeValues[T any](elements []tree[T]) []T {
+	values := make([]T, len(elements))
+	for i, element := range elements {
+		values[i] = element.value
+	}
+	return values
+}
+
+// MapOf generates maps with up to size entries.
+func MapOf[K comparable, V any](keys Arbitrary[K], values Arbitrary[V]) Arbitrary[map[K]V] {
+	type entry struct {
+		key   K
+		value V
+	}
+	entries := Arbitrary[entry]{sample: func(r *rand.Rand, size int) tree[entry] {
+		keyTree := keys.sample(r, size)
+		valueTree := values.sample(r, size)
+		return mapTree(productTree([]tree[any]{mapTree(keyTree, toAny[K]), mapTree(valueTree, toAny[V])}), func(pair []any) entry {
+			return entry{key: pair[0].(K), value: pair[1].(V)}
+		})
+	}}
+	return Map(SliceOf(entries), func(source []entry) map[K]V {
+		result := make(map[K]V, len(source))
+		for _, item := range source {
+			result[item.key] = item.value
+		}
+		return result
+	})
+}
+
+func toAny[T any](value T) any {
+	return value
+}
+
+// Field is an arbitrary that can populate a struct field in StructOf.
+// Every Arbitrary satisfies Field.
+type Field interface {
+	valueType() reflect.Type
+	valueTree(r *rand.Rand, size int) tree[reflect.Value]
+}
+
+func (a Arbitrary[T]) valueType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (a Arbitrary[T]) valueTree(r *rand.Rand, size int) tree[reflect.Value] {
+	return mapTree(a.sample(r, size), func(value T) reflect.Value {
+		result := reflect.New(a.valueType()).Elem()
+		result.Set(reflect.ValueOf(&value).Elem())
+		return result
+	})
+}
+
+// StructOf generates values of the struct type T, filling each named field from its arbitrary.
+// Fields that are not listed keep their zero value.
+// It panics if T is not a struct, or a field is unknown, unexported or of a different type.
+func StructOf[T any](fields map[string]Field) Arbitrary[T] {
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("gen: StructOf requires a struct type, got %v", structType))
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		field, ok := structType.FieldByName(name)
+		if !ok || !field.IsExported() {
+			panic(fmt.Sprintf("gen: StructOf field %s does not exist or is unexported in %v", name, structType))
+		}
+		if fields[name].valueType() != field.Type {
+			panic(fmt.Sprintf("gen: StructOf field %s is %v but the arbitrary generates %v", name, field.Type, fields[name].valueType()))
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return Arbitrary[T]{sample: func(r *rand.Rand, size int) tree[T] {
+		values := make([]tree[reflect.Value], len(names))
+		for i, name := range names {
+			values[i] = fields[name].valueTree(r, size)
+		}
+		return mapTree(productTree(values), func(fieldValues []reflect.Value) T {
+			result := reflect.New(structType).Elem()
+			for i, name := range names {
+				result.FieldByName(name).Set(fieldValues[i])
+			}
+			return result.Interface().(T)
+		})
+	}}
+}
+
+// OneOf picks one of the given arbitraries uniformly for every generated value.
+func OneOf[T any](choices ...Arbitrary[T]) Arbitrary[T] {
+	if len(choices) == 0 {
+		panic("gen: OneOf requires at least one arbitrary")
+	}
+	return Arbitrary[T]{sample: func(r *rand.Rand, size int) tree[T] {
+		return choices[r.Intn(len(choices))].sample(r, size)
+	}}
+}
+
+// maxFilterAttempts bounds how many samples Filter discards before giving up.
+const maxFilterAttempts = 100
+
+// Filter generates only values satisfying the predicate; shrinking never leaves the predicate.
+// It panics if no matching value is found after a bounded number of attempts.
+func Filter[T any](source Arbitrary[T], predicate func(T) bool) Arbitrary[T] {
+	return Arbitrary[T]{sample: func(r *rand.Rand, size int) tree[T] {
+		for attempt := 0; attempt < maxFilterAttempts; attempt++ {
+			candidate := source.sample(r, size)
+			if predicate(candidate.value) {
+				return filterTree(candidate, predicate)
+			}
+		}
+		panic(fmt.Sprintf("gen: Filter discarded %d values in a row", maxFilterAttempts))
+	}}
+}
+
+// Map transforms generated values; shrinking of the source arbitrary is preserved.
+func Map[T1 any, T2 any](source Arbitrary[T1], transform func(T1) T2) Arbitrary[T2] {
+	return Arbitrary[T2]{sample: func(r *rand.Rand, size int) tree[T2] {
+		return mapTree(source.sample(r, size), transform)
+	}}
+}
+
+// TestingT is the subset of testing.TB used by ForAll.
+type TestingT interface {
+	Helper()
+	Fatalf(format string, args ...any)
+}
+
+// Config controls how ForAllWith runs a property.
+type Config struct {
+	Runs       int   // number of generated cases, defaults to 100
+	MaxSize    int   // upper bound of the size parameter, defaults to 100
+	Seed       int64 // random seed, a time-based seed is used when zero
+	MaxShrinks int   // upper bound of shrink steps, defaults to 1000
+}
+
+// ForAll checks the property against randomly generated values using the default Config.
+// On failure it reports the smallest failing value found by shrinking and the seed to reproduce it.
+func ForAll[T any](t TestingT, source Arbitrary[T], property func(T) bool) {
+	t.Helper()
+	ForAllWith(t, Config{}, source, property)
+}
+
+// ForAllWith checks the property against randomly generated values using the given Config.
+func ForAllWith[T any](t TestingT, config Config, source Arbitrary[T], property func(T) bool) {
+	t.Helper()
+	if config.Runs <= 0 {
+		config.Runs = 100
+	}
+	if config.MaxSize <= 0 {
+		config.MaxSize = 100
+	}
+	if config.MaxShrinks <= 0 {
+		config.MaxShrinks = 1000
+	}
+	if config.Seed == 0 {
+		config.Seed = time.Now().UnixNano()
+	}
+
+	r := rand.New(rand.NewSource(config.Seed))
+	for run := 0; run < config.Runs; run++ {
+		size := run * config.MaxSize / config.Runs
+		candidate := source.sample(r, size)
+		if property(candidate.value) {
+			continue
+		}
+
+		shrunk, steps := shrink(candidate, property, config.MaxShrinks)
+		t.Fatalf("gen: property failed after %d runs (seed %d)\noriginal: %#v\nshrunk (%d steps): %#v",
+			run+1, config.Seed, candidate.value, steps, shrunk)
+		return
+	}
+}
+
+// shrink greedily walks the shrink tree towards the smallest value that still fails the property.
+func shrink[T any](failing tree[T], property func(T) bool, maxSteps int) (T, int) {
+	steps := 0
+	for steps < maxSteps {
+		next, found := firstFailing(failing.shrinks(), property)
+		if !found {
+			break
+		}
+		failing = next
+		steps++
+	}
+	return failing.value, steps
+}
+
+func firstFailing[T any](candidates []tree[T], property func(T) bool) (tree[T], bool) {
+	for _, candidate := range candidates {
+		if !property(candidate.value) {
+			return candidate, true
+		}
+	}
+	return tree[T]{}, false
+}
@@ -0,0 +1,150 @@
+package gen
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeT records failures instead of failing the enclosing test.
+type fakeT struct {
+	failed  bool
+	message string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Fatalf(format string, args ...any) {
+	f.failed = true
+	f.message = fmt.Sprintf(format, args...)
+}
+
+func TestGenerators(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	t.Run("Int stays within bounds", func(t *testing.T) {
+		for i := 0; i < 200; i++ {
+			value := Int(-5, 5).Sample(r, 10)
+			assert.GreaterOrEqual(t, value, -5)
+			assert.LessOrEqual(t, value, 5)
+		}
+	})
+
+	t.Run("Int covers ranges wider than MaxInt64", func(t *testing.T) {
+		for i := 0; i < 200; i++ {
+			assert.NotPanics(t, func() { Int(math.MinInt, math.MaxInt).Sample(r, 10) })
+			value := Int(math.MinInt, 1).Sample(r, 10)
+			assert.LessOrEqual(t, value, 1)
+			value = Int(-1, math.MaxInt).Sample(r, 10)
+			assert.GreaterOrEqual(t, value, -1)
+		}
+		assert.Equal(t, math.MaxInt, Int(math.MaxInt, math.MaxInt).Sample(r, 10))
+		assert.Equal(t, math.MinInt, Int(math.MinInt, math.MinInt).Sample(r, 10))
+	})
+
+	t.Run("SliceOf respects size", func(t *testing.T) {
+		for i := 0; i < 50; i++ {
+			assert.LessOrEqual(t, len(SliceOf(Int(0, 9)).Sample(r, 5)), 5)
+		}
+		assert.Empty(t, SliceOf(Int(0, 9)).Sample(r, 0))
+	})
+
+	t.Run("String is printable", func(t *testing.T) {
+		value := String().Sample(r, 50)
+		for _, c := range value {
+			assert.True(t, c >= ' ' && c <= '~')
+		}
+	})
+
+	t.Run("Map and Filter compose", func(t *testing.T) {
+		evens := Filter(Map(Int(0, 100), func(v int) int { return v * 3 }), func(v int) bool { return v%2 == 0 })
+		for i := 0; i < 50; i++ {
+			assert.Equal(t, 0, evens.Sample(r, 10)%2)
+		}
+	})
+
+	t.Run("OneOf picks among choices", func(t *testing.T) {
+		value := OneOf(Const("a"), Const("b")).Sample(r, 1)
+		assert.Contains(t, []string{"a", "b"}, value)
+	})
+
+	t.Run("MapOf builds maps", func(t *testing.T) {
+		value := MapOf(Int(0, 3), String()).Sample(r, 20)
+		assert.LessOrEqual(t, len(value), 4)
+	})
+
+	t.Run("StructOf fills fields", func(t *testing.T) {
+		type Order struct {
+			Code   string
+			Amount int
+			Note   string
+		}
+		value := StructOf[Order](map[string]Field{
+			"Code":   Const("C-1"),
+			"Amount": Int(1, 10),
+		}).Sample(r, 10)
+		assert.Equal(t, "C-1", value.Code)
+		assert.GreaterOrEqual(t, value.Amount, 1)
+		assert.Equal(t, "", value.Note)
+	})
+
+	t.Run("StructOf rejects mismatched fields", func(t *testing.T) {
+		type Order struct{ Amount int }
+		assert.Panics(t, func() { StructOf[Order](map[string]Field{"Amount": String()}) })
+		assert.Panics(t, func() { StructOf[Order](map[string]Field{"Missing": Int(0, 1)}) })
+	})
+}
+
+func TestForAll(t *testing.T) {
+	t.Run("passing property", func(t *testing.T) {
+		ForAll(t, SliceOf(Int(-100, 100)), func(values []int) bool {
+			reversed := make([]int, len(values))
+			for i, v := range values {
+				reversed[len(values)-1-i] = v
+			}
+			return len(reversed) == len(values)
+		})
+	})
+
+	t.Run("failing int property shrinks to the boundary", func(t *testing.T) {
+		ft := &fakeT{}
+		ForAllWith(ft, Config{Seed: 42}, Int(0, 1000), func(v int) bool { return v < 10 })
+
+		assert.True(t, ft.failed)
+		assert.Contains(t, ft.message, "seed 42")
+		assert.True(t, strings.HasSuffix(ft.message, ": 10"), ft.message)
+	})
+
+	t.Run("failing slice property shrinks to a minimal slice", func(t *testing.T) {
+		ft := &fakeT{}
+		ForAllWith(ft, Config{Seed: 7}, SliceOf(Int(0, 100)), func(values []int) bool {
+			for _, v := range values {
+				if v >= 50 {
+					return false
+				}
+			}
+			return true
+		})
+
+		assert.True(t, ft.failed)
+		assert.True(t, strings.HasSuffix(ft.message, "[]int{50}"), ft.message)
+	})
+
+	t.Run("shrinking through StructOf", func(t *testing.T) {
+		type Line struct {
+			Qty   int
+			Price int
+		}
+		ft := &fakeT{}
+		lines := StructOf[Line](map[string]Field{"Qty": Int(0, 50), "Price": Int(0, 50)})
+		ForAllWith(ft, Config{Seed: 3}, lines, func(l Line) bool { return l.Qty < 5 || l.Price < 7 })
+
+		assert.True(t, ft.failed)
+		assert.Contains(t, ft.message, "shrunk")
+		assert.True(t, strings.HasSuffix(ft.message, "{Qty:5, Price:7}"), ft.message)
+	})
+}
@@ -1,11 +1,12 @@
 module github.com/lumiluminousai/golang-fp-utility
 
-go 1.20
+go 1.22.0
 
 require (
 	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/exp v0.0.0-20240909161429-701f63a606c0
+	golang.org/x/text v0.17.0
 )
 
 require (
@@ -0,0 +1,122 @@
+// Package graph provides dependency-graph helpers over nodes described by a dependency function.
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CycleError is returned when the dependencies contain a cycle. Path starts and ends with the same node.
+type CycleError[T comparable] struct {
+	Path []T
+}
+
+func (e *CycleError[T]) Error() string {
+	parts := make([]string, len(e.Path))
+	for i, node := range e.Path {
+		parts[i] = fmt.Sprint(node)
+	}
+	return "graph: cycle detected: " + strings.Join(parts, " -> ")
+}
+
+const (
+	unvisited = iota
+	visiting
+	visited
+)
+
+// TopoSort orders nodes so that every node comes after its dependencies, as returned by depsFn.
+// Dependencies missing from nodes are included in the result. The order is deterministic: nodes are
+// visited in input order and dependencies in the order depsFn returns them. A cycle yields a *CycleError.
+// Example:
+//   - TopoSort([]string{"app"}, deps) returns []string{"db", "cache", "app"} when app depends on db and cache.
+func TopoSort[T comparable](nodes []T, depsFn func(node T) []T) ([]T, error) {
+	state := make(map[T]int)
+	var order []T
+	var stack []T
+
+	var visit func(node T) error
+	visit = func(node T) error {
+		switch state[node] {
+		case visited:
+			return nil
+		case visiting:
+			start := 0
+			for i, n := range stack {
+				if n == node {
+					start = i
+					break
+				}
+			}
+			path := append(append([]T{}, stack[start:]...), node)
+			return &CycleError[T]{Path: path}
+		}
+
+		state[node] = visiting
+		stack = append(stack, node)
+		for _, dep := range depsFn(node) {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[node] = visited
+		order = append(order, node)
+		return nil
+	}
+
+	for _, node := range nodes {
+		if err := visit(node); err != nil {
+			return nil, err
+		}
+	}
+	if order == nil {
+		order = []T{}
+	}
+	return order, nil
+}
+
+// ReachableFrom returns every node reachable from start by following depsFn, in breadth-first order.
+// start itself is only included when it is part of a cycle.
+func ReachableFrom[T comparable](start T, depsFn func(node T) []T) []T {
+	seen := make(map[T]bool)
+	result := []T{}
+	queue := depsFn(start)
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if seen[node] {
+			continue
+		}
+		seen[node] = true
+		result = append(result, node)
+		queue = append(queue, depsFn(node)...)
+	}
+	return result
+}
+
+// FoldDAG computes a result for every node in dependency order: fn receives the node and the
+// results of its dependencies, in the order depsFn returns them. Each node is evaluated once even
+// when it is shared by several dependents. A cycle yields a *CycleError.
+// Example, the critical path of every build target:
+//   - FoldDAG(targets, deps, func(t Target, deps []time.Duration) time.Duration {
+//     longest := time.Duration(0)
+//     for _, d := range deps { longest = max(longest, d) }
+//     return t.Cost + longest
+//     })
+func FoldDAG[T comparable, R any](nodes []T, depsFn func(node T) []T, fn func(node T, deps []R) R) (map[T]R, error) {
+	order, err := TopoSort(nodes, depsFn)
+	if err != nil {
+		return nil, err
+	}
+	results := make(map[T]R, len(order))
+	for _, node := range order {
+		deps := depsFn(node)
+		depResults := make([]R, len(deps))
+		for i, dep := range deps {
+			depResults[i] = results[dep]
+		}
+		results[node] = fn(node, depResults)
+	}
+	return results, nil
+}
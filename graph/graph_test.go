@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func depsOf(edges map[string][]string) func(string) []string {
+	return func(node string) []string { return edges[node] }
+}
+
+var build = map[string][]string{
+	"app":    {"db", "cache"},
+	"db":     {"config"},
+	"cache":  {"config"},
+	"config": nil,
+	"docs":   nil,
+}
+
+func TestTopoSort(t *testing.T) {
+	t.Run("dependencies first", func(t *testing.T) {
+		order, err := TopoSort([]string{"app", "docs"}, depsOf(build))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"config", "db", "cache", "app", "docs"}, order)
+	})
+
+	t.Run("shared dependencies appear once", func(t *testing.T) {
+		order, err := TopoSort([]string{"db", "cache", "app"}, depsOf(build))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"config", "db", "cache", "app"}, order)
+	})
+
+	t.Run("cycle reports its path", func(t *testing.T) {
+		edges := map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"a"}}
+		_, err := TopoSort([]string{"a"}, depsOf(edges))
+		var cycle *CycleError[string]
+		assert.True(t, errors.As(err, &cycle))
+		assert.Equal(t, []string{"a", "b", "c", "a"}, cycle.Path)
+		assert.Equal(t, "graph: cycle detected: a -> b -> c -> a", err.Error())
+	})
+
+	t.Run("cycle not through the start node", func(t *testing.T) {
+		edges := map[string][]string{"root": {"x"}, "x": {"y"}, "y": {"x"}}
+		_, err := TopoSort([]string{"root"}, depsOf(edges))
+		assert.EqualError(t, err, "graph: cycle detected: x -> y -> x")
+	})
+
+	t.Run("self dependency", func(t *testing.T) {
+		_, err := TopoSort([]int{1}, func(n int) []int { return []int{n} })
+		assert.EqualError(t, err, "graph: cycle detected: 1 -> 1")
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		order, err := TopoSort([]string{}, depsOf(build))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, order)
+	})
+}
+
+func TestReachableFrom(t *testing.T) {
+	assert.Equal(t, []string{"db", "cache", "config"}, ReachableFrom("app", depsOf(build)))
+	assert.Equal(t, []string{}, ReachableFrom("config", depsOf(build)))
+
+	cyclic := map[string][]string{"a": {"b"}, "b": {"a"}}
+	assert.Equal(t, []string{"b", "a"}, ReachableFrom("a", depsOf(cyclic)))
+}
+
+func TestFoldDAG(t *testing.T) {
+	t.Run("longest build time", func(t *testing.T) {
+		cost := map[string]int{"app": 5, "db": 3, "cache": 1, "config": 2, "docs": 1}
+		calls := map[string]int{}
+		results, err := FoldDAG([]string{"app", "docs"}, depsOf(build), func(node string, deps []int) int {
+			calls[node]++
+			longest := 0
+			for _, d := range deps {
+				if d > longest {
+					longest = d
+				}
+			}
+			return cost[node] + longest
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"config": 2, "db": 5, "cache": 3, "app": 10, "docs": 1}, results)
+		assert.Equal(t, 1, calls["config"])
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		edges := map[string][]string{"a": {"b"}, "b": {"a"}}
+		results, err := FoldDAG([]string{"a"}, depsOf(edges), func(string, []int) int { return 0 })
+		assert.Error(t, err)
+		assert.Nil(t, results)
+	})
+}
@@ -0,0 +1,40 @@
+package grouping
+
+// GroupBy2 groups elements into two levels, first by key1Fn and then by key2Fn.
+// Example:
+//   - GroupBy2(orders, customerOf, monthOf) returns map[customer]map[month][]Order.
+func GroupBy2[T any, K1 comparable, K2 comparable](slice []T, key1Fn func(T) K1, key2Fn func(T) K2) map[K1]map[K2][]T {
+	result := make(map[K1]map[K2][]T)
+	for _, item := range slice {
+		k1, k2 := key1Fn(item), key2Fn(item)
+		inner, ok := result[k1]
+		if !ok {
+			inner = make(map[K2][]T)
+			result[k1] = inner
+		}
+		inner[k2] = append(inner[k2], item)
+	}
+	return result
+}
+
+// GroupBy2Reduce groups elements into two levels and folds each group with reduceFn as it goes,
+// so the groups themselves are never materialized.
+// Example:
+//   - GroupBy2Reduce(orders, customerOf, monthOf, func(acc float64, o Order) float64 { return acc + o.Amount }, 0)
+func GroupBy2Reduce[T any, K1 comparable, K2 comparable, R any](slice []T, key1Fn func(T) K1, key2Fn func(T) K2, reduceFn func(acc R, item T) R, initialValue R) map[K1]map[K2]R {
+	result := make(map[K1]map[K2]R)
+	for _, item := range slice {
+		k1, k2 := key1Fn(item), key2Fn(item)
+		inner, ok := result[k1]
+		if !ok {
+			inner = make(map[K2]R)
+			result[k1] = inner
+		}
+		acc, ok := inner[k2]
+		if !ok {
+			acc = initialValue
+		}
+		inner[k2] = reduceFn(acc, item)
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package grouping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	Customer string
+	Month    string
+	Amount   float64
+}
+
+var orders = []order{
+	{"C1", "Jan", 100},
+	{"C2", "Jan", 50},
+	{"C1", "Feb", 25},
+	{"C1", "Jan", 10},
+	{"C2", "Mar", 5},
+}
+
+func customerOf(o order) string { return o.Customer }
+func monthOf(o order) string    { return o.Month }
+
+func TestGroupBy2(t *testing.T) {
+	t.Run("two levels keep order within groups", func(t *testing.T) {
+		result := GroupBy2(orders, customerOf, monthOf)
+		assert.Equal(t, map[string]map[string][]order{
+			"C1": {
+				"Jan": {{"C1", "Jan", 100}, {"C1", "Jan", 10}},
+				"Feb": {{"C1", "Feb", 25}},
+			},
+			"C2": {
+				"Jan": {{"C2", "Jan", 50}},
+				"Mar": {{"C2", "Mar", 5}},
+			},
+		}, result)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		assert.Equal(t, map[string]map[string][]order{}, GroupBy2([]order{}, customerOf, monthOf))
+	})
+}
+
+func TestGroupBy2Reduce(t *testing.T) {
+	t.Run("sum per group", func(t *testing.T) {
+		sum := func(acc float64, o order) float64 { return acc + o.Amount }
+		result := GroupBy2Reduce(orders, customerOf, monthOf, sum, 0)
+		assert.Equal(t, map[string]map[string]float64{
+			"C1": {"Jan": 110, "Feb": 25},
+			"C2": {"Jan": 50, "Mar": 5},
+		}, result)
+	})
+
+	t.Run("initial value seeds every group", func(t *testing.T) {
+		count := func(acc int, o order) int { return acc + 1 }
+		result := GroupBy2Reduce(orders, customerOf, monthOf, count, 100)
+		assert.Equal(t, 102, result["C1"]["Jan"])
+		assert.Equal(t, 101, result["C2"]["Mar"])
+	})
+}
@@ -0,0 +1,94 @@
+package grouping
+
+import "fmt"
+
+// Pivot is the result of PivotTable. Rows and Columns list the keys in order of first appearance.
+type Pivot[R comparable, C comparable, A any] struct {
+	Rows         []R
+	Columns      []C
+	Cells        map[R]map[C]A
+	RowTotals    map[R]A
+	ColumnTotals map[C]A
+	GrandTotal   A
+}
+
+// Cell returns the aggregate for a row and column, and whether that combination occurred.
+func (p Pivot[R, C, A]) Cell(row R, column C) (A, bool) {
+	value, ok := p.Cells[row][column]
+	return value, ok
+}
+
+// Records renders the pivot as a grid ready for csv.Writer.WriteAll or an HTML table.
+// The first row holds the column keys and a "Total" column, the last row holds the column totals,
+// and cells for combinations that did not occur are empty.
+func (p Pivot[R, C, A]) Records(formatValue func(A) string) [][]string {
+	header := []string{""}
+	for _, column := range p.Columns {
+		header = append(header, fmt.Sprint(column))
+	}
+	records := [][]string{append(header, "Total")}
+
+	for _, row := range p.Rows {
+		record := []string{fmt.Sprint(row)}
+		for _, column := range p.Columns {
+			value, ok := p.Cell(row, column)
+			if ok {
+				record = append(record, formatValue(value))
+			} else {
+				record = append(record, "")
+			}
+		}
+		records = append(records, append(record, formatValue(p.RowTotals[row])))
+	}
+
+	totals := []string{"Total"}
+	for _, column := range p.Columns {
+		totals = append(totals, formatValue(p.ColumnTotals[column]))
+	}
+	return append(records, append(totals, formatValue(p.GrandTotal)))
+}
+
+// PivotTable cross-tabulates slice by rowKey and colKey. aggFn receives the values extracted by
+// valueFn for each cell, row, column and for the whole slice, so totals are aggregated from the
+// underlying values rather than from cell aggregates (which keeps averages and distinct counts correct).
+// Example:
+//   - PivotTable(orders, customerOf, monthOf, amountOf, sum) returns customers × months with totals.
+func PivotTable[T any, R comparable, C comparable, V any, A any](slice []T, rowKey func(T) R, colKey func(T) C, valueFn func(T) V, aggFn func(values []V) A) Pivot[R, C, A] {
+	cells := make(map[R]map[C][]V)
+	rows := make(map[R][]V)
+	columns := make(map[C][]V)
+	all := make([]V, 0, len(slice))
+	pivot := Pivot[R, C, A]{
+		Cells:        make(map[R]map[C]A),
+		RowTotals:    make(map[R]A),
+		ColumnTotals: make(map[C]A),
+	}
+
+	for _, item := range slice {
+		row, column, value := rowKey(item), colKey(item), valueFn(item)
+		if _, ok := rows[row]; !ok {
+			pivot.Rows = append(pivot.Rows, row)
+			cells[row] = make(map[C][]V)
+		}
+		if _, ok := columns[column]; !ok {
+			pivot.Columns = append(pivot.Columns, column)
+		}
+		cells[row][column] = append(cells[row][column], value)
+		rows[row] = append(rows[row], value)
+		columns[column] = append(columns[column], value)
+		all = append(all, value)
+	}
+
+	for row, byColumn := range cells {
+		pivot.Cells[row] = make(map[C]A, len(byColumn))
+		for column, values := range byColumn {
+			pivot.Cells[row][column] = aggFn(values)
+		}
+		pivot.RowTotals[row] = aggFn(rows[row])
+	}
+	for column, values := range columns {
+		pivot.ColumnTotals[column] = aggFn(values)
+	}
+	pivot.GrandTotal = aggFn(all)
+	return pivot
+}
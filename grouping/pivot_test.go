@@ -0,0 +1,66 @@
+package grouping
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func amountOf(o order) float64 { return o.Amount }
+
+func sum(values []float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func TestPivotTable(t *testing.T) {
+	t.Run("cells and totals", func(t *testing.T) {
+		pivot := PivotTable(orders, customerOf, monthOf, amountOf, sum)
+
+		assert.Equal(t, []string{"C1", "C2"}, pivot.Rows)
+		assert.Equal(t, []string{"Jan", "Feb", "Mar"}, pivot.Columns)
+		assert.Equal(t, map[string]map[string]float64{
+			"C1": {"Jan": 110, "Feb": 25},
+			"C2": {"Jan": 50, "Mar": 5},
+		}, pivot.Cells)
+		assert.Equal(t, map[string]float64{"C1": 135, "C2": 55}, pivot.RowTotals)
+		assert.Equal(t, map[string]float64{"Jan": 160, "Feb": 25, "Mar": 5}, pivot.ColumnTotals)
+		assert.Equal(t, 190.0, pivot.GrandTotal)
+
+		value, ok := pivot.Cell("C1", "Feb")
+		assert.True(t, ok)
+		assert.Equal(t, 25.0, value)
+		_, ok = pivot.Cell("C2", "Feb")
+		assert.False(t, ok)
+	})
+
+	t.Run("totals aggregate underlying values", func(t *testing.T) {
+		average := func(values []float64) float64 { return sum(values) / float64(len(values)) }
+		pivot := PivotTable(orders, customerOf, monthOf, amountOf, average)
+		assert.InDelta(t, 45.0, pivot.RowTotals["C1"], 1e-9)
+		assert.InDelta(t, 38.0, pivot.GrandTotal, 1e-9)
+	})
+
+	t.Run("renders as CSV", func(t *testing.T) {
+		pivot := PivotTable(orders, customerOf, monthOf, amountOf, sum)
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		assert.NoError(t, writer.WriteAll(pivot.Records(func(v float64) string {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		})))
+		assert.Equal(t, ",Jan,Feb,Mar,Total\nC1,110,25,,135\nC2,50,,5,55\nTotal,160,25,5,190\n", buf.String())
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		pivot := PivotTable([]order{}, customerOf, monthOf, amountOf, sum)
+		assert.Empty(t, pivot.Rows)
+		assert.Equal(t, 0.0, pivot.GrandTotal)
+		assert.Equal(t, [][]string{{"", "Total"}, {"Total", "0"}}, pivot.Records(func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }))
+	})
+}
@@ -0,0 +1,63 @@
+package grouping
+
+// RollupRow is one aggregate produced by Rollup. Keys holds the key prefix the row covers:
+// a full key path for detail rows, a shorter prefix for subtotals and no keys for the grand total.
+type RollupRow[K comparable, A any] struct {
+	Keys  []K
+	Value A
+}
+
+// IsTotal reports whether the row is a subtotal or the grand total for the given number of key levels.
+func (r RollupRow[K, A]) IsTotal(levels int) bool {
+	return len(r.Keys) < levels
+}
+
+// rollupNode collects the values under one key prefix, with children in order of first appearance.
+type rollupNode[K comparable, V any] struct {
+	values   []V
+	order    []K
+	children map[K]*rollupNode[K, V]
+}
+
+func (n *rollupNode[K, V]) child(key K) *rollupNode[K, V] {
+	if n.children == nil {
+		n.children = make(map[K]*rollupNode[K, V])
+	}
+	c, ok := n.children[key]
+	if !ok {
+		c = &rollupNode[K, V]{}
+		n.children[key] = c
+		n.order = append(n.order, key)
+	}
+	return c
+}
+
+// Rollup aggregates slice at every prefix of the key hierarchy, like SQL GROUP BY ROLLUP.
+// Rows are returned depth-first in order of first appearance, with each subtotal following its
+// detail rows and the grand total last. aggFn receives the underlying values of each group.
+// Example:
+//   - Rollup(sales, []func(Sale) string{regionOf, cityOf}, amountOf, sum) returns region/city rows,
+//     a subtotal per region and a grand total.
+func Rollup[T any, K comparable, V any, A any](slice []T, keyFns []func(T) K, valueFn func(T) V, aggFn func(values []V) A) []RollupRow[K, A] {
+	root := &rollupNode[K, V]{}
+	for _, item := range slice {
+		value := valueFn(item)
+		node := root
+		node.values = append(node.values, value)
+		for _, keyFn := range keyFns {
+			node = node.child(keyFn(item))
+			node.values = append(node.values, value)
+		}
+	}
+
+	var rows []RollupRow[K, A]
+	var walk func(node *rollupNode[K, V], keys []K)
+	walk = func(node *rollupNode[K, V], keys []K) {
+		for _, key := range node.order {
+			walk(node.children[key], append(keys[:len(keys):len(keys)], key))
+		}
+		rows = append(rows, RollupRow[K, A]{Keys: keys, Value: aggFn(node.values)})
+	}
+	walk(root, []K{})
+	return rows
+}
@@ -0,0 +1,53 @@
+package grouping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollup(t *testing.T) {
+	t.Run("subtotals per prefix and grand total", func(t *testing.T) {
+		rows := Rollup(orders, []func(order) string{customerOf, monthOf}, amountOf, sum)
+		assert.Equal(t, []RollupRow[string, float64]{
+			{Keys: []string{"C1", "Jan"}, Value: 110},
+			{Keys: []string{"C1", "Feb"}, Value: 25},
+			{Keys: []string{"C1"}, Value: 135},
+			{Keys: []string{"C2", "Jan"}, Value: 50},
+			{Keys: []string{"C2", "Mar"}, Value: 5},
+			{Keys: []string{"C2"}, Value: 55},
+			{Keys: []string{}, Value: 190},
+		}, rows)
+	})
+
+	t.Run("IsTotal", func(t *testing.T) {
+		rows := Rollup(orders, []func(order) string{customerOf, monthOf}, amountOf, sum)
+		totals := 0
+		for _, row := range rows {
+			if row.IsTotal(2) {
+				totals++
+			}
+		}
+		assert.Equal(t, 3, totals)
+	})
+
+	t.Run("aggregates underlying values", func(t *testing.T) {
+		count := func(values []float64) int { return len(values) }
+		rows := Rollup(orders, []func(order) string{customerOf}, amountOf, count)
+		assert.Equal(t, []RollupRow[string, int]{
+			{Keys: []string{"C1"}, Value: 3},
+			{Keys: []string{"C2"}, Value: 2},
+			{Keys: []string{}, Value: 5},
+		}, rows)
+	})
+
+	t.Run("no key functions", func(t *testing.T) {
+		rows := Rollup[order, string](orders, nil, amountOf, sum)
+		assert.Equal(t, []RollupRow[string, float64]{{Keys: []string{}, Value: 190}}, rows)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		rows := Rollup([]order{}, []func(order) string{customerOf}, amountOf, sum)
+		assert.Equal(t, []RollupRow[string, float64]{{Keys: []string{}, Value: 0}}, rows)
+	})
+}
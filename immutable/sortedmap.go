@@ -0,0 +1,250 @@
+package immutable
+
+import (
+	"golang.org/x/exp/constraints"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+// mapNode is a node of the AVL tree behind SortedMap. Nodes are never modified after creation.
+type mapNode[K constraints.Ordered, V any] struct {
+	key         K
+	value       V
+	left, right *mapNode[K, V]
+	height      int
+}
+
+// SortedMap is a persistent map ordered by key, implemented as a path-copying AVL tree.
+// Get, Set, Delete, Floor and Ceiling run in O(log n), and iteration is in ascending key order.
+// The zero value is an empty map ready to use.
+type SortedMap[K constraints.Ordered, V any] struct {
+	root *mapNode[K, V]
+	size int
+}
+
+// SortedMapOf creates a SortedMap from a Go map.
+func SortedMapOf[K constraints.Ordered, V any](source map[K]V) SortedMap[K, V] {
+	var m SortedMap[K, V]
+	for key, value := range source {
+		m = m.Set(key, value)
+	}
+	return m
+}
+
+// Len returns the number of entries.
+func (m SortedMap[K, V]) Len() int {
+	return m.size
+}
+
+// Get returns the value stored under key and whether it is present.
+func (m SortedMap[K, V]) Get(key K) (value V, ok bool) {
+	n := m.root
+	for n != nil {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	return value, false
+}
+
+// Set returns a map with key mapped to value.
+func (m SortedMap[K, V]) Set(key K, value V) SortedMap[K, V] {
+	root, added := insertNode(m.root, key, value)
+	m.root = root
+	if added {
+		m.size++
+	}
+	return m
+}
+
+// Delete returns a map without key. It returns m unchanged when key is absent.
+func (m SortedMap[K, V]) Delete(key K) SortedMap[K, V] {
+	root, removed := deleteNode(m.root, key)
+	if !removed {
+		return m
+	}
+	return SortedMap[K, V]{root: root, size: m.size - 1}
+}
+
+// Floor returns the entry with the greatest key less than or equal to key.
+func (m SortedMap[K, V]) Floor(key K) (entry tuple.Pair[K, V], ok bool) {
+	for n := m.root; n != nil; {
+		switch {
+		case key < n.key:
+			n = n.left
+		default:
+			entry, ok = tuple.NewPair(n.key, n.value), true
+			if key == n.key {
+				return entry, ok
+			}
+			n = n.right
+		}
+	}
+	return entry, ok
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key.
+func (m SortedMap[K, V]) Ceiling(key K) (entry tuple.Pair[K, V], ok bool) {
+	for n := m.root; n != nil; {
+		switch {
+		case key > n.key:
+			n = n.right
+		default:
+			entry, ok = tuple.NewPair(n.key, n.value), true
+			if key == n.key {
+				return entry, ok
+			}
+			n = n.left
+		}
+	}
+	return entry, ok
+}
+
+// Range returns the entries with from <= key < to in ascending key order.
+// Example:
+//   - Range("2024-01", "2024-04") returns the first quarter of monthly totals.
+func (m SortedMap[K, V]) Range(from, to K) []tuple.Pair[K, V] {
+	entries := []tuple.Pair[K, V]{}
+	var walk func(n *mapNode[K, V])
+	walk = func(n *mapNode[K, V]) {
+		if n == nil {
+			return
+		}
+		if from < n.key {
+			walk(n.left)
+		}
+		if from <= n.key && n.key < to {
+			entries = append(entries, tuple.NewPair(n.key, n.value))
+		}
+		if n.key < to {
+			walk(n.right)
+		}
+	}
+	walk(m.root)
+	return entries
+}
+
+// ForEach calls fn for every entry in ascending key order until fn returns false.
+func (m SortedMap[K, V]) ForEach(fn func(key K, value V) bool) {
+	var walk func(n *mapNode[K, V]) bool
+	walk = func(n *mapNode[K, V]) bool {
+		if n == nil {
+			return true
+		}
+		return walk(n.left) && fn(n.key, n.value) && walk(n.right)
+	}
+	walk(m.root)
+}
+
+// Entries returns every entry in ascending key order.
+func (m SortedMap[K, V]) Entries() []tuple.Pair[K, V] {
+	entries := make([]tuple.Pair[K, V], 0, m.size)
+	m.ForEach(func(key K, value V) bool {
+		entries = append(entries, tuple.NewPair(key, value))
+		return true
+	})
+	return entries
+}
+
+// Keys returns every key in ascending order.
+func (m SortedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.ForEach(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func heightOf[K constraints.Ordered, V any](n *mapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// newMapNode creates a node, computing its height from its children.
+func newMapNode[K constraints.Ordered, V any](key K, value V, left, right *mapNode[K, V]) *mapNode[K, V] {
+	return &mapNode[K, V]{key: key, value: value, left: left, right: right, height: max(heightOf(left), heightOf(right)) + 1}
+}
+
+// balanced creates a node like newMapNode and restores the AVL invariant with rotations.
+func balanced[K constraints.Ordered, V any](key K, value V, left, right *mapNode[K, V]) *mapNode[K, V] {
+	switch diff := heightOf(left) - heightOf(right); {
+	case diff > 1:
+		if heightOf(left.left) < heightOf(left.right) {
+			left = rotateLeft(left)
+		}
+		return newMapNode(left.key, left.value, left.left, newMapNode(key, value, left.right, right))
+	case diff < -1:
+		if heightOf(right.right) < heightOf(right.left) {
+			right = rotateRight(right)
+		}
+		return newMapNode(right.key, right.value, newMapNode(key, value, left, right.left), right.right)
+	default:
+		return newMapNode(key, value, left, right)
+	}
+}
+
+func rotateLeft[K constraints.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	r := n.right
+	return newMapNode(r.key, r.value, newMapNode(n.key, n.value, n.left, r.left), r.right)
+}
+
+func rotateRight[K constraints.Ordered, V any](n *mapNode[K, V]) *mapNode[K, V] {
+	l := n.left
+	return newMapNode(l.key, l.value, l.left, newMapNode(n.key, n.value, l.right, n.right))
+}
+
+func insertNode[K constraints.Ordered, V any](n *mapNode[K, V], key K, value V) (*mapNode[K, V], bool) {
+	if n == nil {
+		return newMapNode[K, V](key, value, nil, nil), true
+	}
+	switch {
+	case key < n.key:
+		left, added := insertNode(n.left, key, value)
+		return balanced(n.key, n.value, left, n.right), added
+	case key > n.key:
+		right, added := insertNode(n.right, key, value)
+		return balanced(n.key, n.value, n.left, right), added
+	default:
+		return newMapNode(key, value, n.left, n.right), false
+	}
+}
+
+func deleteNode[K constraints.Ordered, V any](n *mapNode[K, V], key K) (*mapNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	switch {
+	case key < n.key:
+		left, removed := deleteNode(n.left, key)
+		if !removed {
+			return n, false
+		}
+		return balanced(n.key, n.value, left, n.right), true
+	case key > n.key:
+		right, removed := deleteNode(n.right, key)
+		if !removed {
+			return n, false
+		}
+		return balanced(n.key, n.value, n.left, right), true
+	}
+	if n.left == nil {
+		return n.right, true
+	}
+	if n.right == nil {
+		return n.left, true
+	}
+	successor := n.right
+	for successor.left != nil {
+		successor = successor.left
+	}
+	right, _ := deleteNode(n.right, successor.key)
+	return balanced(successor.key, successor.value, n.left, right), true
+}
@@ -0,0 +1,114 @@
+package immutable
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+func TestSortedMap(t *testing.T) {
+	t.Run("zero value is empty", func(t *testing.T) {
+		var m SortedMap[string, int]
+		assert.Equal(t, 0, m.Len())
+		_, ok := m.Get("a")
+		assert.False(t, ok)
+		assert.Equal(t, []tuple.Pair[string, int]{}, m.Entries())
+	})
+
+	t.Run("set, get and in-order iteration", func(t *testing.T) {
+		m := SortedMapOf(map[string]int{"c": 3, "a": 1, "b": 2})
+		assert.Equal(t, 3, m.Len())
+		assert.Equal(t, []string{"a", "b", "c"}, m.Keys())
+		value, ok := m.Get("b")
+		assert.True(t, ok)
+		assert.Equal(t, 2, value)
+
+		replaced := m.Set("b", 20)
+		assert.Equal(t, 3, replaced.Len())
+		value, _ = replaced.Get("b")
+		assert.Equal(t, 20, value)
+		value, _ = m.Get("b")
+		assert.Equal(t, 2, value, "the original is unchanged")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m := SortedMapOf(map[int]string{1: "a", 2: "b", 3: "c"})
+		without := m.Delete(2)
+		assert.Equal(t, []int{1, 3}, without.Keys())
+		assert.Equal(t, []int{1, 2, 3}, m.Keys())
+		assert.Equal(t, m, m.Delete(4))
+	})
+
+	t.Run("ForEach stops early", func(t *testing.T) {
+		m := SortedMapOf(map[int]bool{1: true, 2: true, 3: true})
+		visited := []int{}
+		m.ForEach(func(key int, _ bool) bool {
+			visited = append(visited, key)
+			return key < 2
+		})
+		assert.Equal(t, []int{1, 2}, visited)
+	})
+
+	t.Run("matches a Go map under random updates", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		reference := map[int]int{}
+		var m SortedMap[int, int]
+		for i := 0; i < 5000; i++ {
+			key := rng.Intn(500)
+			if rng.Intn(3) == 0 {
+				delete(reference, key)
+				m = m.Delete(key)
+			} else {
+				reference[key] = i
+				m = m.Set(key, i)
+			}
+		}
+		keys := make([]int, 0, len(reference))
+		for key := range reference {
+			keys = append(keys, key)
+		}
+		sort.Ints(keys)
+		assert.Equal(t, len(reference), m.Len())
+		assert.Equal(t, keys, m.Keys())
+		for key, value := range reference {
+			got, ok := m.Get(key)
+			assert.True(t, ok)
+			assert.Equal(t, value, got)
+		}
+		assert.LessOrEqual(t, heightOf(m.root), 2*10)
+	})
+}
+
+func TestSortedMapRangeQueries(t *testing.T) {
+	m := SortedMapOf(map[int]string{10: "a", 20: "b", 30: "c", 40: "d"})
+
+	t.Run("Floor", func(t *testing.T) {
+		entry, ok := m.Floor(25)
+		assert.True(t, ok)
+		assert.Equal(t, tuple.NewPair(20, "b"), entry)
+		entry, _ = m.Floor(30)
+		assert.Equal(t, 30, entry.First)
+		_, ok = m.Floor(5)
+		assert.False(t, ok)
+	})
+
+	t.Run("Ceiling", func(t *testing.T) {
+		entry, ok := m.Ceiling(25)
+		assert.True(t, ok)
+		assert.Equal(t, tuple.NewPair(30, "c"), entry)
+		entry, _ = m.Ceiling(10)
+		assert.Equal(t, 10, entry.First)
+		_, ok = m.Ceiling(41)
+		assert.False(t, ok)
+	})
+
+	t.Run("Range is half-open", func(t *testing.T) {
+		assert.Equal(t, []tuple.Pair[int, string]{{First: 20, Second: "b"}, {First: 30, Second: "c"}}, m.Range(15, 40))
+		assert.Equal(t, []tuple.Pair[int, string]{}, m.Range(41, 50))
+		assert.Equal(t, m.Entries(), m.Range(0, 100))
+	})
+}
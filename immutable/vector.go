@@ -0,0 +1,206 @@
+// Package immutable provides persistent collections. Every update returns a new collection that
+// shares most of its structure with the original, which stays unchanged.
+package immutable
+
+const (
+	bits  = 5
+	width = 1 << bits
+	mask  = width - 1
+)
+
+// node is a trie node: inner nodes hold children, leaves hold values.
+type node[T any] struct {
+	children []*node[T]
+	values   []T
+}
+
+// Vector is a persistent indexed sequence implemented as a 32-way bit-partitioned trie with a tail
+// buffer. Get, Set and Append run in O(log32 n), which is effectively constant, and Slice is O(1).
+// The zero value is an empty vector ready to use.
+type Vector[T any] struct {
+	root  *node[T]
+	tail  []T
+	shift uint
+	// size is the number of elements stored in root and tail; the vector exposes [start, end).
+	size       int
+	start, end int
+}
+
+// VectorOf creates a vector holding the given items.
+func VectorOf[T any](items ...T) Vector[T] {
+	return FromSlice(items)
+}
+
+// FromSlice creates a vector holding the elements of source.
+func FromSlice[T any](source []T) Vector[T] {
+	var v Vector[T]
+	for _, item := range source {
+		v = v.Append(item)
+	}
+	return v
+}
+
+// Len returns the number of elements.
+func (v Vector[T]) Len() int {
+	return v.end - v.start
+}
+
+// Get returns the element at index i, or the zero value and false when i is out of range.
+func (v Vector[T]) Get(i int) (value T, ok bool) {
+	if i < 0 || i >= v.Len() {
+		return value, false
+	}
+	index := v.start + i
+	return v.leafFor(index)[index&mask], true
+}
+
+// Set returns a vector with the element at index i replaced by value.
+// Like indexing a slice, it panics when i is out of range.
+func (v Vector[T]) Set(i int, value T) Vector[T] {
+	if i < 0 || i >= v.Len() {
+		panic("immutable: Vector.Set index out of range")
+	}
+	return v.assoc(v.start+i, value)
+}
+
+// Append returns a vector with value added at the end.
+func (v Vector[T]) Append(value T) Vector[T] {
+	if v.end < v.size {
+		// A Slice hides elements after end; overwrite the first hidden one instead of growing.
+		result := v.assoc(v.end, value)
+		result.end++
+		return result
+	}
+	result := v.push(value)
+	result.end++
+	return result
+}
+
+// Slice returns the elements in [from, to) as a vector sharing the structure of v.
+// Like slicing a slice, it panics unless 0 <= from <= to <= Len(). Elements outside the range
+// stay reachable until the slice itself is released.
+func (v Vector[T]) Slice(from, to int) Vector[T] {
+	if from < 0 || to < from || to > v.Len() {
+		panic("immutable: Vector.Slice bounds out of range")
+	}
+	v.end = v.start + to
+	v.start += from
+	return v
+}
+
+// ToSlice returns the elements as a new slice.
+func (v Vector[T]) ToSlice() []T {
+	result := make([]T, 0, v.Len())
+	for i := v.start; i < v.end; {
+		leaf := v.leafFor(i)
+		offset := i & mask
+		count := len(leaf) - offset
+		if remaining := v.end - i; count > remaining {
+			count = remaining
+		}
+		result = append(result, leaf[offset:offset+count]...)
+		i += count
+	}
+	return result
+}
+
+// tailOffset is the index of the first element held in the tail.
+func (v Vector[T]) tailOffset() int {
+	if v.size < width {
+		return 0
+	}
+	return ((v.size - 1) >> bits) << bits
+}
+
+// leafFor returns the leaf values holding index.
+func (v Vector[T]) leafFor(index int) []T {
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+	n := v.root
+	for level := v.shift; level > 0; level -= bits {
+		n = n.children[(index>>level)&mask]
+	}
+	return n.values
+}
+
+// assoc returns a copy of v with the element at the absolute index replaced.
+func (v Vector[T]) assoc(index int, value T) Vector[T] {
+	if index >= v.tailOffset() {
+		tail := append([]T(nil), v.tail...)
+		tail[index&mask] = value
+		v.tail = tail
+		return v
+	}
+	v.root = assocNode(v.shift, v.root, index, value)
+	return v
+}
+
+func assocNode[T any](level uint, n *node[T], index int, value T) *node[T] {
+	if level == 0 {
+		values := append([]T(nil), n.values...)
+		values[index&mask] = value
+		return &node[T]{values: values}
+	}
+	children := append([]*node[T](nil), n.children...)
+	sub := (index >> level) & mask
+	children[sub] = assocNode(level-bits, children[sub], index, value)
+	return &node[T]{children: children}
+}
+
+// push returns a copy of v with value stored at index size.
+func (v Vector[T]) push(value T) Vector[T] {
+	if v.root == nil {
+		v.root = &node[T]{}
+		v.shift = bits
+	}
+	if v.size-v.tailOffset() < width {
+		tail := make([]T, len(v.tail), len(v.tail)+1)
+		copy(tail, v.tail)
+		v.tail = append(tail, value)
+		v.size++
+		return v
+	}
+
+	full := &node[T]{values: v.tail}
+	if (v.size >> bits) > (1 << v.shift) {
+		v.root = &node[T]{children: []*node[T]{v.root, newPath(v.shift, full)}}
+		v.shift += bits
+	} else {
+		v.root = v.pushTail(v.shift, v.root, full)
+	}
+	v.tail = []T{value}
+	v.size++
+	return v
+}
+
+// pushTail copies the path to the rightmost leaf position and inserts the full tail there.
+func (v Vector[T]) pushTail(level uint, parent *node[T], tail *node[T]) *node[T] {
+	sub := ((v.size - 1) >> level) & mask
+	children := make([]*node[T], len(parent.children), len(parent.children)+1)
+	copy(children, parent.children)
+
+	var inserted *node[T]
+	switch {
+	case level == bits:
+		inserted = tail
+	case sub < len(children):
+		inserted = v.pushTail(level-bits, children[sub], tail)
+	default:
+		inserted = newPath(level-bits, tail)
+	}
+	if sub < len(children) {
+		children[sub] = inserted
+	} else {
+		children = append(children, inserted)
+	}
+	return &node[T]{children: children}
+}
+
+// newPath wraps leaf in single-child inner nodes up to level.
+func newPath[T any](level uint, leaf *node[T]) *node[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &node[T]{children: []*node[T]{newPath(level-bits, leaf)}}
+}
@@ -0,0 +1,106 @@
+package immutable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVector(t *testing.T) {
+	t.Run("zero value is empty", func(t *testing.T) {
+		var v Vector[int]
+		assert.Equal(t, 0, v.Len())
+		_, ok := v.Get(0)
+		assert.False(t, ok)
+		assert.Equal(t, []int{}, v.ToSlice())
+	})
+
+	t.Run("append and get across trie levels", func(t *testing.T) {
+		const n = 40000
+		var v Vector[int]
+		for i := 0; i < n; i++ {
+			v = v.Append(i)
+		}
+		assert.Equal(t, n, v.Len())
+		for _, i := range []int{0, 31, 32, 1023, 1024, 1056, 32767, 32768, n - 1} {
+			value, ok := v.Get(i)
+			assert.True(t, ok)
+			assert.Equal(t, i, value)
+		}
+		_, ok := v.Get(n)
+		assert.False(t, ok)
+		_, ok = v.Get(-1)
+		assert.False(t, ok)
+
+		expected := make([]int, n)
+		for i := range expected {
+			expected[i] = i
+		}
+		assert.Equal(t, expected, v.ToSlice())
+	})
+
+	t.Run("updates leave earlier versions unchanged", func(t *testing.T) {
+		base := FromSlice(make([]int, 100))
+		updated := base.Set(5, 1).Set(99, 2)
+		appended := base.Append(3)
+		other := base.Append(4)
+
+		assert.Equal(t, make([]int, 100), base.ToSlice())
+		first, _ := updated.Get(5)
+		last, _ := updated.Get(99)
+		assert.Equal(t, []int{1, 2}, []int{first, last})
+		tail, _ := appended.Get(100)
+		assert.Equal(t, 3, tail)
+		tail, _ = other.Get(100)
+		assert.Equal(t, 4, tail)
+	})
+
+	t.Run("Set out of range panics", func(t *testing.T) {
+		assert.Panics(t, func() { VectorOf(1).Set(1, 0) })
+	})
+}
+
+func TestVectorSlice(t *testing.T) {
+	v := FromSlice([]string{"a", "b", "c", "d", "e"})
+
+	middle := v.Slice(1, 4)
+	assert.Equal(t, 3, middle.Len())
+	assert.Equal(t, []string{"b", "c", "d"}, middle.ToSlice())
+	first, _ := middle.Get(0)
+	assert.Equal(t, "b", first)
+	_, ok := middle.Get(3)
+	assert.False(t, ok)
+
+	t.Run("append to a slice does not overwrite the original", func(t *testing.T) {
+		extended := middle.Append("x")
+		assert.Equal(t, []string{"b", "c", "d", "x"}, extended.ToSlice())
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, v.ToSlice())
+		assert.Equal(t, []string{"b", "c", "d"}, middle.ToSlice())
+	})
+
+	t.Run("append to a slice of a deep vector", func(t *testing.T) {
+		source := make([]int, 200)
+		for i := range source {
+			source[i] = i
+		}
+		big := FromSlice(source)
+		prefix := big.Slice(0, 10).Append(-1)
+
+		value, _ := prefix.Get(10)
+		assert.Equal(t, -1, value)
+		value, _ = big.Get(10)
+		assert.Equal(t, 10, value)
+		assert.Equal(t, source, big.ToSlice())
+	})
+
+	t.Run("Set on a slice", func(t *testing.T) {
+		assert.Equal(t, []string{"b", "C", "d"}, middle.Set(1, "C").ToSlice())
+		assert.Equal(t, "c", func() string { s, _ := v.Get(2); return s }())
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		assert.Equal(t, 0, v.Slice(5, 5).Len())
+		assert.Panics(t, func() { v.Slice(2, 1) })
+		assert.Panics(t, func() { v.Slice(0, 6) })
+	})
+}
@@ -0,0 +1,103 @@
+// Package lens provides optics for reading and immutably updating nested structures.
+package lens
+
+import (
+	option "github.com/lumiluminousai/golang-fp-utility/option"
+)
+
+// Lens focuses on a part A of a structure S. Set and Modify return an updated copy of S;
+// the setter passed to New must not modify its argument in place.
+type Lens[S any, A any] struct {
+	get func(S) A
+	set func(S, A) S
+}
+
+// New creates a Lens from a getter and a setter. Struct values are copied on the way in,
+// so a setter can assign to its parameter and return it.
+// Example:
+//   - New(func(c Config) DB { return c.DB }, func(c Config, db DB) Config { c.DB = db; return c })
+func New[S any, A any](get func(S) A, set func(S, A) S) Lens[S, A] {
+	return Lens[S, A]{get: get, set: set}
+}
+
+// Get returns the focused part of s.
+func (l Lens[S, A]) Get(s S) A {
+	return l.get(s)
+}
+
+// Set returns a copy of s with the focused part replaced by value.
+func (l Lens[S, A]) Set(s S, value A) S {
+	return l.set(s, value)
+}
+
+// Modify returns a copy of s with fn applied to the focused part.
+func (l Lens[S, A]) Modify(s S, fn func(A) A) S {
+	return l.set(s, fn(l.get(s)))
+}
+
+// Compose focuses inner on the part selected by outer, e.g. Config.DB followed by DB.Pool.
+func Compose[S any, A any, B any](outer Lens[S, A], inner Lens[A, B]) Lens[S, B] {
+	return Lens[S, B]{
+		get: func(s S) B { return inner.get(outer.get(s)) },
+		set: func(s S, value B) S { return outer.set(s, inner.set(outer.get(s), value)) },
+	}
+}
+
+// Prism focuses on a part A of S that may be absent, such as an optional pointer field.
+type Prism[S any, A any] struct {
+	getOption func(S) option.Option[A]
+	set       func(S, A) S
+}
+
+// NewPrism creates a Prism from a getter returning an Option and a setter.
+func NewPrism[S any, A any](getOption func(S) option.Option[A], set func(S, A) S) Prism[S, A] {
+	return Prism[S, A]{getOption: getOption, set: set}
+}
+
+// Pointer turns a lens on an optional pointer field into a Prism on the pointed-to value.
+// Set stores a pointer to a new copy, so the original pointee is never modified.
+func Pointer[S any, A any](l Lens[S, *A]) Prism[S, A] {
+	return Prism[S, A]{
+		getOption: func(s S) option.Option[A] { return option.FromPointer(l.get(s)) },
+		set:       func(s S, value A) S { return l.set(s, &value) },
+	}
+}
+
+// FromLens views a Lens as a Prism whose part is always present.
+func FromLens[S any, A any](l Lens[S, A]) Prism[S, A] {
+	return Prism[S, A]{
+		getOption: func(s S) option.Option[A] { return option.Some(l.get(s)) },
+		set:       l.set,
+	}
+}
+
+// GetOption returns the focused part of s, or None when it is absent.
+func (p Prism[S, A]) GetOption(s S) option.Option[A] {
+	return p.getOption(s)
+}
+
+// Set returns a copy of s with the focused part set to value, making it present.
+func (p Prism[S, A]) Set(s S, value A) S {
+	return p.set(s, value)
+}
+
+// Modify returns a copy of s with fn applied to the focused part, or s unchanged when it is absent.
+func (p Prism[S, A]) Modify(s S, fn func(A) A) S {
+	value, ok := p.getOption(s).Get()
+	if !ok {
+		return s
+	}
+	return p.set(s, fn(value))
+}
+
+// ComposePrism focuses inner on the part selected by outer. The result is absent when either part is.
+func ComposePrism[S any, A any, B any](outer Prism[S, A], inner Prism[A, B]) Prism[S, B] {
+	return Prism[S, B]{
+		getOption: func(s S) option.Option[B] {
+			return option.FlatMap(outer.getOption(s), inner.getOption)
+		},
+		set: func(s S, value B) S {
+			return outer.Modify(s, func(a A) A { return inner.set(a, value) })
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package lens
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	option "github.com/lumiluminousai/golang-fp-utility/option"
+)
+
+type pool struct{ Size int }
+
+type database struct {
+	Host string
+	Pool pool
+}
+
+type tls struct{ CertFile string }
+
+type config struct {
+	DB  database
+	TLS *tls
+}
+
+var (
+	dbLens = New(
+		func(c config) database { return c.DB },
+		func(c config, db database) config { c.DB = db; return c },
+	)
+	poolLens = New(
+		func(db database) pool { return db.Pool },
+		func(db database, p pool) database { db.Pool = p; return db },
+	)
+	sizeLens = New(
+		func(p pool) int { return p.Size },
+		func(p pool, size int) pool { p.Size = size; return p },
+	)
+	tlsLens = New(
+		func(c config) *tls { return c.TLS },
+		func(c config, t *tls) config { c.TLS = t; return c },
+	)
+	certLens = New(
+		func(t tls) string { return t.CertFile },
+		func(t tls, file string) tls { t.CertFile = file; return t },
+	)
+)
+
+func TestLens(t *testing.T) {
+	cfg := config{DB: database{Host: "db", Pool: pool{Size: 10}}}
+	poolSize := Compose(Compose(dbLens, poolLens), sizeLens)
+
+	assert.Equal(t, 10, poolSize.Get(cfg))
+
+	updated := poolSize.Set(cfg, 20)
+	assert.Equal(t, 20, updated.DB.Pool.Size)
+	assert.Equal(t, "db", updated.DB.Host)
+	assert.Equal(t, 10, cfg.DB.Pool.Size, "the original is unchanged")
+
+	doubled := poolSize.Modify(cfg, func(n int) int { return n * 2 })
+	assert.Equal(t, 20, doubled.DB.Pool.Size)
+}
+
+func TestPrism(t *testing.T) {
+	certFile := ComposePrism(Pointer(tlsLens), FromLens(certLens))
+
+	t.Run("absent", func(t *testing.T) {
+		cfg := config{}
+		assert.Equal(t, option.None[string](), certFile.GetOption(cfg))
+		assert.Equal(t, cfg, certFile.Modify(cfg, func(s string) string { return s + ".pem" }))
+		assert.Equal(t, cfg, certFile.Set(cfg, "x.pem"), "setting through an absent outer part is a no-op")
+	})
+
+	t.Run("present", func(t *testing.T) {
+		original := &tls{CertFile: "server"}
+		cfg := config{TLS: original}
+		assert.Equal(t, option.Some("server"), certFile.GetOption(cfg))
+
+		updated := certFile.Modify(cfg, func(s string) string { return s + ".pem" })
+		assert.Equal(t, "server.pem", updated.TLS.CertFile)
+		assert.Equal(t, "server", original.CertFile, "the original pointee is unchanged")
+	})
+
+	t.Run("Pointer Set makes the part present", func(t *testing.T) {
+		updated := Pointer(tlsLens).Set(config{}, tls{CertFile: "a.pem"})
+		assert.Equal(t, "a.pem", updated.TLS.CertFile)
+	})
+}
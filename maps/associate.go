@@ -0,0 +1,49 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateKey is returned by AssociateUnique and KeyByUnique when two elements produce the same key.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// Associate builds a map from the key/value pairs returned by pairFunc for each element.
+// When several elements produce the same key, the last one wins.
+// Example:
+//   - Associate(users, func(u User) (int, string) { return u.ID, u.Name })
+func Associate[T any, K comparable, V any](source []T, pairFunc func(item T) (K, V)) map[K]V {
+	result := make(map[K]V, len(source))
+	for _, item := range source {
+		key, value := pairFunc(item)
+		result[key] = value
+	}
+	return result
+}
+
+// AssociateUnique is like Associate but fails with ErrDuplicateKey, naming the key and index,
+// when two elements produce the same key.
+func AssociateUnique[T any, K comparable, V any](source []T, pairFunc func(item T) (K, V)) (map[K]V, error) {
+	result := make(map[K]V, len(source))
+	for idx, item := range source {
+		key, value := pairFunc(item)
+		if _, ok := result[key]; ok {
+			return nil, fmt.Errorf("%w: %v at index %d", ErrDuplicateKey, key, idx)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+// KeyBy builds a lookup map from the key returned by keyFunc to the element.
+// When several elements produce the same key, the last one wins.
+// Example:
+//   - KeyBy(users, func(u User) int { return u.ID })
+func KeyBy[T any, K comparable](source []T, keyFunc func(item T) K) map[K]T {
+	return Associate(source, func(item T) (K, T) { return keyFunc(item), item })
+}
+
+// KeyByUnique is like KeyBy but fails with ErrDuplicateKey when two elements produce the same key.
+func KeyByUnique[T any, K comparable](source []T, keyFunc func(item T) K) (map[K]T, error) {
+	return AssociateUnique(source, func(item T) (K, T) { return keyFunc(item), item })
+}
@@ -0,0 +1,46 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	ID   int
+	Name string
+}
+
+func TestAssociate(t *testing.T) {
+	users := []user{{1, "ann"}, {2, "bob"}, {1, "anna"}}
+
+	t.Run("last wins", func(t *testing.T) {
+		names := Associate(users, func(u user) (int, string) { return u.ID, u.Name })
+		assert.Equal(t, map[int]string{1: "anna", 2: "bob"}, names)
+	})
+
+	t.Run("unique rejects duplicates", func(t *testing.T) {
+		_, err := AssociateUnique(users, func(u user) (int, string) { return u.ID, u.Name })
+		assert.ErrorIs(t, err, ErrDuplicateKey)
+		assert.Contains(t, err.Error(), "1 at index 2")
+
+		names, err := AssociateUnique(users[:2], func(u user) (int, string) { return u.ID, u.Name })
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]string{1: "ann", 2: "bob"}, names)
+	})
+}
+
+func TestKeyBy(t *testing.T) {
+	users := []user{{1, "ann"}, {2, "bob"}, {1, "anna"}}
+	byID := func(u user) int { return u.ID }
+
+	assert.Equal(t, map[int]user{1: {1, "anna"}, 2: {2, "bob"}}, KeyBy(users, byID))
+	assert.Empty(t, KeyBy([]user{}, byID))
+
+	_, err := KeyByUnique(users, byID)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+
+	lookup, err := KeyByUnique(users[1:], byID)
+	assert.NoError(t, err)
+	assert.Equal(t, "anna", lookup[1].Name)
+}
@@ -0,0 +1,27 @@
+package maps
+
+import (
+	"fmt"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+)
+
+// InvertMap swaps the keys and values of a map. When several keys share a value, which of them
+// is kept is unspecified; use InvertMapGrouped to keep all of them.
+// Example:
+//   - InvertMap(map[string]string{"US": "United States"}) returns map[United States:US].
+func InvertMap[K comparable, V comparable](source map[K]V) map[V]K {
+	result := make(map[V]K, len(source))
+	for key, value := range source {
+		result[value] = key
+	}
+	return result
+}
+
+// InvertMapGrouped maps every value to all keys holding it. The keys of each group are in
+// the same order as MapHashMapToList (sorted by their printed form), so the result is deterministic.
+func InvertMapGrouped[K comparable, V comparable](source map[K]V) map[V][]K {
+	keys := Keys(source)
+	collection.Sort(keys, func(i, j int) bool { return fmt.Sprintf("%v", keys[i]) < fmt.Sprintf("%v", keys[j]) })
+	return collection.GroupBy(keys, func(key K) V { return source[key] })
+}
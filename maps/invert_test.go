@@ -0,0 +1,26 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvertMap(t *testing.T) {
+	codes := map[string]string{"US": "United States", "DE": "Germany"}
+	assert.Equal(t, map[string]string{"United States": "US", "Germany": "DE"}, InvertMap(codes))
+	assert.Empty(t, InvertMap(map[string]int{}))
+
+	shared := InvertMap(map[string]int{"a": 1, "b": 1})
+	assert.Len(t, shared, 1)
+	assert.Contains(t, []string{"a", "b"}, shared[1])
+}
+
+func TestInvertMapGrouped(t *testing.T) {
+	status := map[string]string{"S3": "open", "S1": "open", "S2": "shipped"}
+	assert.Equal(t, map[string][]string{
+		"open":    {"S1", "S3"},
+		"shipped": {"S2"},
+	}, InvertMapGrouped(status))
+	assert.Empty(t, InvertMapGrouped(map[int]int{}))
+}
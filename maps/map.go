@@ -40,6 +40,25 @@ func MapHashMapToHashMap[K comparable, V1 any, V2 any](source map[K]V1, mappingF
 	return result
 }
 
+// MapValues applies a transformation function to every value of a hashmap, keeping the keys.
+func MapValues[K comparable, V1 any, V2 any](source map[K]V1, mappingFunc func(value V1) V2) map[K]V2 {
+	result := make(map[K]V2, len(source))
+	for key, value := range source {
+		result[key] = mappingFunc(value)
+	}
+	return result
+}
+
+// MapKeys applies a transformation function to every key of a hashmap, keeping the values.
+// When two keys map to the same new key, which of their values is kept is unspecified.
+func MapKeys[K1 comparable, K2 comparable, V any](source map[K1]V, mappingFunc func(key K1) K2) map[K2]V {
+	result := make(map[K2]V, len(source))
+	for key, value := range source {
+		result[mappingFunc(key)] = value
+	}
+	return result
+}
+
 // MapHashMapToHashMapReturnWithError applies a transformation function to a hashmap and handles errors.
 func MapHashMapToHashMapReturnWithError[K comparable, V1 any, V2 any](source map[K]V1, mappingFunc func(key K, value V1) (V2, error)) (map[K]V2, error) {
 	result := make(map[K]V2)
@@ -83,6 +102,24 @@ func MapHashMapToListReturnWithError[K comparable, V1 any, V2 any](source map[K]
 	return result, nil
 }
 
+// Keys returns the keys of a hashmap in unspecified order. Use SortedKeys for a deterministic order.
+func Keys[K comparable, V any](source map[K]V) []K {
+	keys := make([]K, 0, len(source))
+	for key := range source {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns the values of a hashmap in unspecified order. Use SortedValuesByKey for a deterministic order.
+func Values[K comparable, V any](source map[K]V) []V {
+	values := make([]V, 0, len(source))
+	for _, value := range source {
+		values = append(values, value)
+	}
+	return values
+}
+
 // SliceToHashMap converts a slice to a map with boolean values indicating presence.
 func SliceToHashMap[T comparable](list []T) map[T]bool {
 	result := make(map[T]bool)
@@ -396,3 +396,28 @@ func TestMapToHashMapReturnWithError(t *testing.T) {
 	})
 
 }
+
+func TestMapValues(t *testing.T) {
+	source := map[string]int{"apple": 1, "banana": 2}
+	result := MapValues(source, func(value int) string { return strconv.Itoa(value * 10) })
+	assert.Equal(t, map[string]string{"apple": "10", "banana": "20"}, result)
+	assert.Equal(t, map[string]int{"apple": 1, "banana": 2}, source)
+	assert.Empty(t, MapValues(map[string]int{}, strconv.Itoa))
+}
+
+func TestMapKeys(t *testing.T) {
+	source := map[int]string{1: "one", 2: "two"}
+	result := MapKeys(source, func(key int) string { return "k" + strconv.Itoa(key) })
+	assert.Equal(t, map[string]string{"k1": "one", "k2": "two"}, result)
+
+	collapsed := MapKeys(source, func(int) bool { return true })
+	assert.Len(t, collapsed, 1)
+}
+
+func TestKeysAndValues(t *testing.T) {
+	source := map[string]int{"apple": 1, "banana": 2}
+	assert.ElementsMatch(t, []string{"apple", "banana"}, Keys(source))
+	assert.ElementsMatch(t, []int{1, 2}, Values(source))
+	assert.Equal(t, []string{}, Keys(map[string]int{}))
+	assert.Equal(t, []int{}, Values(map[string]int(nil)))
+}
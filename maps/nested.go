@@ -0,0 +1,110 @@
+package maps
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+)
+
+// FlattenValues concatenates the slices of a map into one slice. Keys are visited in the same
+// order as MapHashMapToList (sorted by their printed form), so the result is deterministic.
+func FlattenValues[K comparable, V any](source map[K][]V) []V {
+	return collection.FlatMap(MapHashMapToList(source, func(_ K, values []V) []V { return values }))
+}
+
+// GetPath looks up a dotted path in a decoded JSON or YAML document. Path segments select map
+// keys, or indexes when the current value is a []any.
+// Example:
+//   - GetPath(doc, "spec.containers.0.image") returns the first container image.
+func GetPath(source map[string]any, path string) (any, bool) {
+	var current any = source
+	for _, segment := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[segment]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			current = node[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// SetPath returns a deep copy of source with the value at the dotted path replaced. Missing
+// intermediate maps are created; the source document is never modified. Setting through a value
+// that is neither a map nor a []any, or an index out of range, returns an error.
+func SetPath(source map[string]any, path string, value any) (map[string]any, error) {
+	copied, _ := deepCopyDocument(source).(map[string]any)
+	if copied == nil {
+		copied = map[string]any{}
+	}
+	segments := strings.Split(path, ".")
+
+	var current any = copied
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		switch node := current.(type) {
+		case map[string]any:
+			if last {
+				node[segment] = value
+				return copied, nil
+			}
+			next, ok := node[segment]
+			if !ok || next == nil {
+				next = map[string]any{}
+				node[segment] = next
+			}
+			current = next
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("setPath: %s: invalid index %q", path, segment)
+			}
+			if last {
+				node[index] = value
+				return copied, nil
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("setPath: %s: cannot descend into %T at %q", path, current, strings.Join(segments[:i], "."))
+		}
+	}
+	return copied, nil
+}
+
+// deepCopyDocument copies the maps and slices of a decoded document; other values are shared.
+func deepCopyDocument(value any) any {
+	switch node := value.(type) {
+	case map[string]any:
+		if node == nil {
+			return node
+		}
+		copied := make(map[string]any, len(node))
+		for k, v := range node {
+			copied[k] = deepCopyDocument(v)
+		}
+		return copied
+	case []any:
+		if node == nil {
+			return node
+		}
+		copied := make([]any, len(node))
+		for i, v := range node {
+			copied[i] = deepCopyDocument(v)
+		}
+		return copied
+	default:
+		return value
+	}
+}
@@ -0,0 +1,80 @@
+package maps
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenValues(t *testing.T) {
+	source := map[string][]int{"b": {3, 4}, "a": {1, 2}, "c": nil}
+	assert.Equal(t, []int{1, 2, 3, 4}, FlattenValues(source))
+	assert.Equal(t, []int{}, FlattenValues(map[string][]int{}))
+}
+
+func decode(t *testing.T, document string) map[string]any {
+	var result map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(document), &result))
+	return result
+}
+
+const podSpec = `{"spec":{"replicas":2,"containers":[{"image":"nginx"},{"image":"redis"}]}}`
+
+func TestGetPath(t *testing.T) {
+	doc := decode(t, podSpec)
+
+	tests := []struct {
+		path     string
+		expected any
+		found    bool
+	}{
+		{"spec.replicas", 2.0, true},
+		{"spec.containers.1.image", "redis", true},
+		{"spec.containers.2.image", nil, false},
+		{"spec.containers.x", nil, false},
+		{"spec.missing", nil, false},
+		{"spec.replicas.value", nil, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			value, found := GetPath(doc, tc.path)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestSetPath(t *testing.T) {
+	t.Run("replaces a nested value on a copy", func(t *testing.T) {
+		doc := decode(t, podSpec)
+		updated, err := SetPath(doc, "spec.containers.0.image", "nginx:1.27")
+		assert.NoError(t, err)
+
+		image, _ := GetPath(updated, "spec.containers.0.image")
+		assert.Equal(t, "nginx:1.27", image)
+		assert.Equal(t, decode(t, podSpec), doc)
+	})
+
+	t.Run("creates missing maps", func(t *testing.T) {
+		updated, err := SetPath(map[string]any{}, "metadata.labels.app", "web")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"metadata": map[string]any{"labels": map[string]any{"app": "web"}}}, updated)
+	})
+
+	t.Run("nil source", func(t *testing.T) {
+		updated, err := SetPath(nil, "a", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"a": 1}, updated)
+	})
+
+	t.Run("cannot descend into a scalar", func(t *testing.T) {
+		_, err := SetPath(decode(t, podSpec), "spec.replicas.value", 3)
+		assert.EqualError(t, err, `setPath: spec.replicas.value: cannot descend into float64 at "spec.replicas"`)
+	})
+
+	t.Run("invalid index", func(t *testing.T) {
+		_, err := SetPath(decode(t, podSpec), "spec.containers.5.image", "x")
+		assert.EqualError(t, err, `setPath: spec.containers.5.image: invalid index "5"`)
+	})
+}
@@ -0,0 +1,43 @@
+package maps
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+// SortedEntries returns the key/value pairs of a map ordered by lessByKey.
+// Example:
+//   - SortedEntries(counts, func(a, b string) bool { return a < b })
+func SortedEntries[K comparable, V any](source map[K]V, lessByKey func(a, b K) bool) []tuple.Pair[K, V] {
+	entries := make([]tuple.Pair[K, V], 0, len(source))
+	for key, value := range source {
+		entries = append(entries, tuple.NewPair(key, value))
+	}
+	sort.Slice(entries, func(i, j int) bool { return lessByKey(entries[i].First, entries[j].First) })
+	return entries
+}
+
+// SortedEntriesByKey returns the key/value pairs of a map in ascending key order.
+func SortedEntriesByKey[K constraints.Ordered, V any](source map[K]V) []tuple.Pair[K, V] {
+	return SortedEntries(source, func(a, b K) bool { return a < b })
+}
+
+// SortedValuesByKey returns the values of a map in ascending order of their keys.
+func SortedValuesByKey[K constraints.Ordered, V any](source map[K]V) []V {
+	entries := SortedEntriesByKey(source)
+	values := make([]V, len(entries))
+	for i, entry := range entries {
+		values[i] = entry.Second
+	}
+	return values
+}
+
+// SortedKeys returns the keys of a map in ascending order.
+func SortedKeys[K constraints.Ordered, V any](source map[K]V) []K {
+	keys := Keys(source)
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,61 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+func TestSortedEntries(t *testing.T) {
+	t.Run("custom key order", func(t *testing.T) {
+		source := map[string]int{"apple": 1, "banana": 2, "kiwi": 3}
+		byLength := func(a, b string) bool { return len(a) < len(b) }
+		result := SortedEntries(source, byLength)
+		assert.Equal(t, []tuple.Pair[string, int]{
+			{First: "kiwi", Second: 3},
+			{First: "apple", Second: 1},
+			{First: "banana", Second: 2},
+		}, result)
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		result := SortedEntries(map[string]int{}, func(a, b string) bool { return a < b })
+		assert.Equal(t, []tuple.Pair[string, int]{}, result)
+	})
+}
+
+func TestSortedEntriesByKey(t *testing.T) {
+	source := map[int][]string{3: {"c"}, 1: {"a"}, 2: {"b", "bb"}}
+	result := SortedEntriesByKey(source)
+	assert.Equal(t, []tuple.Pair[int, []string]{
+		{First: 1, Second: []string{"a"}},
+		{First: 2, Second: []string{"b", "bb"}},
+		{First: 3, Second: []string{"c"}},
+	}, result)
+}
+
+func TestSortedValuesByKey(t *testing.T) {
+	t.Run("ordered by key", func(t *testing.T) {
+		source := map[string]int{"cherry": 3, "apple": 1, "banana": 2}
+		assert.Equal(t, []int{1, 2, 3}, SortedValuesByKey(source))
+	})
+
+	t.Run("deterministic across calls", func(t *testing.T) {
+		source := map[int]string{}
+		for i := 0; i < 100; i++ {
+			source[i] = string(rune('a' + i%26))
+		}
+		assert.Equal(t, SortedValuesByKey(source), SortedValuesByKey(source))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		assert.Equal(t, []int{}, SortedValuesByKey(map[string]int{}))
+	})
+}
+
+func TestSortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"apple", "banana", "kiwi"}, SortedKeys(map[string]int{"kiwi": 3, "apple": 1, "banana": 2}))
+	assert.Equal(t, []int{}, SortedKeys(map[int]bool{}))
+}
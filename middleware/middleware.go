@@ -0,0 +1,20 @@
+// Package middleware composes http.Handler middleware.
+package middleware
+
+import "net/http"
+
+// Middleware wraps an http.Handler with additional behaviour.
+type Middleware = func(http.Handler) http.Handler
+
+// ComposeMiddleware combines middleware into one. The first middleware is outermost, so requests
+// pass through them in the order given and responses in reverse.
+// Example:
+//   - ComposeMiddleware(recoverPanics, logRequests, authenticate)(mux)
+func ComposeMiddleware(mw ...Middleware) Middleware {
+	return func(handler http.Handler) http.Handler {
+		for i := len(mw) - 1; i >= 0; i-- {
+			handler = mw[i](handler)
+		}
+		return handler
+	}
+}
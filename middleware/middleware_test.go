@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func tracing(name string, trace *[]string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*trace = append(*trace, name+" in")
+			next.ServeHTTP(w, r)
+			*trace = append(*trace, name+" out")
+		})
+	}
+}
+
+func TestComposeMiddleware(t *testing.T) {
+	t.Run("first middleware is outermost", func(t *testing.T) {
+		var trace []string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			trace = append(trace, "handler")
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		composed := ComposeMiddleware(tracing("a", &trace), tracing("b", &trace))(handler)
+		recorder := httptest.NewRecorder()
+		composed.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+		assert.Equal(t, []string{"a in", "b in", "handler", "b out", "a out"}, trace)
+	})
+
+	t.Run("short-circuiting middleware", func(t *testing.T) {
+		deny := func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			})
+		}
+		called := false
+		handler := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { called = true })
+
+		recorder := httptest.NewRecorder()
+		ComposeMiddleware(deny)(handler).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.False(t, called)
+	})
+
+	t.Run("no middleware", func(t *testing.T) {
+		handler := http.NotFoundHandler()
+		recorder := httptest.NewRecorder()
+		ComposeMiddleware()(handler).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
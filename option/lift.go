@@ -0,0 +1,40 @@
+package option
+
+// FromTuple converts an idiomatic (value, ok) pair into an Option.
+func FromTuple[T any](value T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// Optionify lifts a function returning (value, ok) into one returning an Option.
+// Example:
+//   - lookup := Optionify(func(key string) (string, bool) { return os.LookupEnv(key) })
+func Optionify[A any, B any](fn func(A) (B, bool)) func(A) Option[B] {
+	return func(a A) Option[B] {
+		return FromTuple(fn(a))
+	}
+}
+
+// Lift2 adapts a plain two-parameter function to Options: the result is Some only when both inputs are.
+// Example:
+//   - Lift2(func(a, b int) int { return a + b })(Some(1), Some(2)) returns Some(3).
+func Lift2[A any, B any, C any](fn func(A, B) C) func(Option[A], Option[B]) Option[C] {
+	return func(a Option[A], b Option[B]) Option[C] {
+		if !a.ok || !b.ok {
+			return None[C]()
+		}
+		return Some(fn(a.value, b.value))
+	}
+}
+
+// Lift3 adapts a plain three-parameter function to Options: the result is Some only when all inputs are.
+func Lift3[A any, B any, C any, D any](fn func(A, B, C) D) func(Option[A], Option[B], Option[C]) Option[D] {
+	return func(a Option[A], b Option[B], c Option[C]) Option[D] {
+		if !a.ok || !b.ok || !c.ok {
+			return None[D]()
+		}
+		return Some(fn(a.value, b.value, c.value))
+	}
+}
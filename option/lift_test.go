@@ -0,0 +1,43 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromTuple(t *testing.T) {
+	assert.Equal(t, Some(1), FromTuple(1, true))
+	assert.Equal(t, None[int](), FromTuple(1, false))
+}
+
+func TestOptionify(t *testing.T) {
+	ages := map[string]int{"alice": 30}
+	lookup := Optionify(func(name string) (int, bool) {
+		age, ok := ages[name]
+		return age, ok
+	})
+
+	value, ok := lookup("alice").Get()
+	assert.True(t, ok)
+	assert.Equal(t, 30, value)
+	assert.True(t, lookup("bob").IsNone())
+}
+
+func TestLift2(t *testing.T) {
+	add := Lift2(func(a, b int) int { return a + b })
+	assert.Equal(t, Some(3), add(Some(1), Some(2)))
+	assert.Equal(t, None[int](), add(None[int](), Some(2)))
+	assert.Equal(t, None[int](), add(Some(1), None[int]()))
+}
+
+func TestLift3(t *testing.T) {
+	join := Lift3(func(a string, b int, c bool) string {
+		if c {
+			return a + string(rune('0'+b))
+		}
+		return a
+	})
+	assert.Equal(t, Some("x1"), join(Some("x"), Some(1), Some(true)))
+	assert.Equal(t, None[string](), join(Some("x"), None[int](), Some(true)))
+}
@@ -0,0 +1,92 @@
+package option
+
+// Option represents a value that may or may not be present.
+// The zero value of Option is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some wraps a present value.
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+// None returns an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPointer returns Some of the pointed-to value, or None when the pointer is nil.
+func FromPointer[T any](value *T) Option[T] {
+	if value == nil {
+		return None[T]()
+	}
+	return Some(*value)
+}
+
+// IsSome reports whether the option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether the option is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the wrapped value and whether it was present.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// ToPointer returns a pointer to a copy of the value, or nil when the option is empty.
+func (o Option[T]) ToPointer() *T {
+	if !o.ok {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
+// Map applies transform to the value of a Some and returns None otherwise.
+func Map[T1 any, T2 any](source Option[T1], transform func(T1) T2) Option[T2] {
+	if !source.ok {
+		return None[T2]()
+	}
+	return Some(transform(source.value))
+}
+
+// FlatMap applies an Option-returning transform to the value of a Some and returns None otherwise.
+// Example:
+//   - FlatMap(FindUser(id), func(u User) Option[Address] { return u.Address })
+func FlatMap[T1 any, T2 any](source Option[T1], transform func(T1) Option[T2]) Option[T2] {
+	if !source.ok {
+		return None[T2]()
+	}
+	return transform(source.value)
+}
+
+// Filter keeps the value only when it satisfies the predicate.
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+	if !o.ok || !predicate(o.value) {
+		return None[T]()
+	}
+	return o
+}
+
+// GetOrElse returns the value, or fallback when the option is empty.
+func (o Option[T]) GetOrElse(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.value
+}
+
+// OrElse returns the option itself when it holds a value, and alternative otherwise.
+func (o Option[T]) OrElse(alternative Option[T]) Option[T] {
+	if !o.ok {
+		return alternative
+	}
+	return o
+}
@@ -0,0 +1,85 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSomeAndNone(t *testing.T) {
+	t.Run("Some holds a value", func(t *testing.T) {
+		opt := Some(42)
+
+		value, ok := opt.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 42, value)
+		assert.True(t, opt.IsSome())
+		assert.False(t, opt.IsNone())
+	})
+
+	t.Run("None is empty", func(t *testing.T) {
+		opt := None[string]()
+
+		value, ok := opt.Get()
+		assert.False(t, ok)
+		assert.Equal(t, "", value)
+		assert.True(t, opt.IsNone())
+	})
+
+	t.Run("zero value is None", func(t *testing.T) {
+		var opt Option[int]
+		assert.True(t, opt.IsNone())
+	})
+}
+
+func TestPointerConversions(t *testing.T) {
+	t.Run("nil pointer becomes None", func(t *testing.T) {
+		assert.True(t, FromPointer[int](nil).IsNone())
+		assert.Nil(t, None[int]().ToPointer())
+	})
+
+	t.Run("pointer round trip copies the value", func(t *testing.T) {
+		source := 7
+		opt := FromPointer(&source)
+		source = 8
+
+		value, ok := opt.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 7, value)
+
+		ptr := opt.ToPointer()
+		assert.Equal(t, 7, *ptr)
+	})
+}
+
+func TestMapAndFlatMap(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	assert.Equal(t, Some(3), Map(Some("abc"), length))
+	assert.Equal(t, None[int](), Map(None[string](), length))
+
+	positive := func(n int) Option[int] {
+		if n > 0 {
+			return Some(n)
+		}
+		return None[int]()
+	}
+	assert.Equal(t, Some(3), FlatMap(Some(3), positive))
+	assert.Equal(t, None[int](), FlatMap(Some(-3), positive))
+	assert.Equal(t, None[int](), FlatMap(None[int](), positive))
+}
+
+func TestFilter(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	assert.Equal(t, Some(2), Some(2).Filter(even))
+	assert.Equal(t, None[int](), Some(3).Filter(even))
+	assert.Equal(t, None[int](), None[int]().Filter(even))
+}
+
+func TestGetOrElseAndOrElse(t *testing.T) {
+	assert.Equal(t, 1, Some(1).GetOrElse(9))
+	assert.Equal(t, 9, None[int]().GetOrElse(9))
+
+	assert.Equal(t, Some(1), Some(1).OrElse(Some(2)))
+	assert.Equal(t, Some(2), None[int]().OrElse(Some(2)))
+	assert.Equal(t, None[int](), None[int]().OrElse(None[int]()))
+}
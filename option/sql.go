@@ -0,0 +1,239 @@
+package option
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// OptionFromNullString converts a sql.NullString into an Option.
+func OptionFromNullString(source sql.NullString) Option[string] {
+	if !source.Valid {
+		return None[string]()
+	}
+	return Some(source.String)
+}
+
+// NullStringFromOption converts an Option into a sql.NullString.
+func NullStringFromOption(source Option[string]) sql.NullString {
+	value, ok := source.Get()
+	return sql.NullString{String: value, Valid: ok}
+}
+
+// OptionFromNullInt64 converts a sql.NullInt64 into an Option.
+func OptionFromNullInt64(source sql.NullInt64) Option[int64] {
+	if !source.Valid {
+		return None[int64]()
+	}
+	return Some(source.Int64)
+}
+
+// NullInt64FromOption converts an Option into a sql.NullInt64.
+func NullInt64FromOption(source Option[int64]) sql.NullInt64 {
+	value, ok := source.Get()
+	return sql.NullInt64{Int64: value, Valid: ok}
+}
+
+// OptionFromNullInt32 converts a sql.NullInt32 into an Option.
+func OptionFromNullInt32(source sql.NullInt32) Option[int32] {
+	if !source.Valid {
+		return None[int32]()
+	}
+	return Some(source.Int32)
+}
+
+// NullInt32FromOption converts an Option into a sql.NullInt32.
+func NullInt32FromOption(source Option[int32]) sql.NullInt32 {
+	value, ok := source.Get()
+	return sql.NullInt32{Int32: value, Valid: ok}
+}
+
+// OptionFromNullInt16 converts a sql.NullInt16 into an Option.
+func OptionFromNullInt16(source sql.NullInt16) Option[int16] {
+	if !source.Valid {
+		return None[int16]()
+	}
+	return Some(source.Int16)
+}
+
+// NullInt16FromOption converts an Option into a sql.NullInt16.
+func NullInt16FromOption(source Option[int16]) sql.NullInt16 {
+	value, ok := source.Get()
+	return sql.NullInt16{Int16: value, Valid: ok}
+}
+
+// OptionFromNullByte converts a sql.NullByte into an Option.
+func OptionFromNullByte(source sql.NullByte) Option[byte] {
+	if !source.Valid {
+		return None[byte]()
+	}
+	return Some(source.Byte)
+}
+
+// NullByteFromOption converts an Option into a sql.NullByte.
+func NullByteFromOption(source Option[byte]) sql.NullByte {
+	value, ok := source.Get()
+	return sql.NullByte{Byte: value, Valid: ok}
+}
+
+// OptionFromNullFloat64 converts a sql.NullFloat64 into an Option.
+func OptionFromNullFloat64(source sql.NullFloat64) Option[float64] {
+	if !source.Valid {
+		return None[float64]()
+	}
+	return Some(source.Float64)
+}
+
+// NullFloat64FromOption converts an Option into a sql.NullFloat64.
+func NullFloat64FromOption(source Option[float64]) sql.NullFloat64 {
+	value, ok := source.Get()
+	return sql.NullFloat64{Float64: value, Valid: ok}
+}
+
+// OptionFromNullBool converts a sql.NullBool into an Option.
+func OptionFromNullBool(source sql.NullBool) Option[bool] {
+	if !source.Valid {
+		return None[bool]()
+	}
+	return Some(source.Bool)
+}
+
+// NullBoolFromOption converts an Option into a sql.NullBool.
+func NullBoolFromOption(source Option[bool]) sql.NullBool {
+	value, ok := source.Get()
+	return sql.NullBool{Bool: value, Valid: ok}
+}
+
+// OptionFromNullTime converts a sql.NullTime into an Option.
+func OptionFromNullTime(source sql.NullTime) Option[time.Time] {
+	if !source.Valid {
+		return None[time.Time]()
+	}
+	return Some(source.Time)
+}
+
+// NullTimeFromOption converts an Option into a sql.NullTime.
+func NullTimeFromOption(source Option[time.Time]) sql.NullTime {
+	value, ok := source.Get()
+	return sql.NullTime{Time: value, Valid: ok}
+}
+
+// NullToOption converts any sql-null-like value into an Option.
+// It works with every sql.Null* type as well as custom types implementing driver.Valuer:
+// a nil driver value becomes None, anything else is converted to T.
+// Example:
+//   - NullToOption[int32](sql.NullInt32{Int32: 7, Valid: true}) returns Some(int32(7)).
+func NullToOption[T any](source driver.Valuer) (Option[T], error) {
+	value, err := source.Value()
+	if err != nil {
+		return None[T](), err
+	}
+	return fromDriverValue[T](value)
+}
+
+// Scan implements sql.Scanner so an Option can be used directly as a scan destination.
+func (o *Option[T]) Scan(src any) error {
+	result, err := fromDriverValue[T](src)
+	if err != nil {
+		return err
+	}
+	*o = result
+	return nil
+}
+
+// Value implements driver.Valuer so an Option can be used directly as a query argument.
+func (o Option[T]) Value() (driver.Value, error) {
+	if !o.ok {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// fromDriverValue converts a raw driver value into an Option of the requested type.
+func fromDriverValue[T any](src any) (Option[T], error) {
+	if src == nil {
+		return None[T](), nil
+	}
+	if raw, ok := src.([]byte); ok {
+		// Drivers reuse the buffer for the next row, so it must not be kept.
+		src = bytes.Clone(raw)
+	}
+	if value, ok := src.(T); ok {
+		return Some(value), nil
+	}
+
+	target := reflect.TypeOf((*T)(nil)).Elem()
+	source := reflect.ValueOf(src)
+	switch {
+	case target.Kind() == reflect.String && source.Kind() == reflect.Slice && source.Type().Elem().Kind() == reflect.Uint8:
+		// Drivers commonly return text columns as []byte.
+		return Some(reflect.ValueOf(string(source.Bytes())).Convert(target).Interface().(T)), nil
+	case isNumericKind(target.Kind()) && isNumericKind(source.Kind()):
+		converted, ok := convertNumber(source, target)
+		if !ok {
+			return None[T](), fmt.Errorf("option: %v does not fit %v", src, target)
+		}
+		return Some(converted.Interface().(T)), nil
+	}
+	return None[T](), fmt.Errorf("option: cannot convert %T to %v", src, target)
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// convertNumber converts a numeric value to dest and reports false when the conversion is lossy:
+// a fraction or out-of-range value converted to an integer, a sign change, or a float that
+// does not fit a narrower float type.
+func convertNumber(value reflect.Value, dest reflect.Type) (reflect.Value, bool) {
+	if isFloatKind(value.Kind()) && isFloatKind(dest.Kind()) {
+		return value.Convert(dest), !reflect.Zero(dest).OverflowFloat(value.Float())
+	}
+	if isFloatKind(value.Kind()) && (math.IsNaN(value.Float()) || math.IsInf(value.Float(), 0)) {
+		return reflect.Value{}, false
+	}
+	converted := value.Convert(dest)
+	if isNegative(value) != isNegative(converted) {
+		return reflect.Value{}, false
+	}
+	back := converted.Convert(value.Type())
+	switch {
+	case isFloatKind(value.Kind()):
+		return converted, back.Float() == value.Float()
+	case value.CanInt():
+		return converted, back.Int() == value.Int()
+	default:
+		return converted, back.Uint() == value.Uint()
+	}
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isNegative(value reflect.Value) bool {
+	switch {
+	case isFloatKind(value.Kind()):
+		return value.Float() < 0
+	case value.CanInt():
+		return value.Int() < 0
+	default:
+		return false
+	}
+}
+
+// Interface guards.
+var (
+	_ sql.Scanner   = (*Option[string])(nil)
+	_ driver.Valuer = Option[string]{}
+)
@@ -0,0 +1,135 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullTypeBridges(t *testing.T) {
+	t.Run("NullString", func(t *testing.T) {
+		assert.Equal(t, Some("abc"), OptionFromNullString(sql.NullString{String: "abc", Valid: true}))
+		assert.Equal(t, None[string](), OptionFromNullString(sql.NullString{}))
+		assert.Equal(t, sql.NullString{String: "abc", Valid: true}, NullStringFromOption(Some("abc")))
+		assert.Equal(t, sql.NullString{}, NullStringFromOption(None[string]()))
+	})
+
+	t.Run("NullInt64", func(t *testing.T) {
+		assert.Equal(t, Some(int64(10)), OptionFromNullInt64(sql.NullInt64{Int64: 10, Valid: true}))
+		assert.Equal(t, None[int64](), OptionFromNullInt64(sql.NullInt64{Int64: 10}))
+		assert.Equal(t, sql.NullInt64{Int64: 10, Valid: true}, NullInt64FromOption(Some(int64(10))))
+	})
+
+	t.Run("NullTime", func(t *testing.T) {
+		now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		assert.Equal(t, Some(now), OptionFromNullTime(sql.NullTime{Time: now, Valid: true}))
+		assert.Equal(t, sql.NullTime{}, NullTimeFromOption(None[time.Time]()))
+	})
+
+	t.Run("NullBool and NullFloat64", func(t *testing.T) {
+		assert.Equal(t, Some(true), OptionFromNullBool(sql.NullBool{Bool: true, Valid: true}))
+		assert.Equal(t, sql.NullFloat64{Float64: 1.5, Valid: true}, NullFloat64FromOption(Some(1.5)))
+	})
+}
+
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("boom")
+}
+
+func TestNullToOption(t *testing.T) {
+	t.Run("valid value of the same type", func(t *testing.T) {
+		result, err := NullToOption[string](sql.NullString{String: "x", Valid: true})
+		assert.NoError(t, err)
+		assert.Equal(t, Some("x"), result)
+	})
+
+	t.Run("numeric value is converted", func(t *testing.T) {
+		result, err := NullToOption[int32](sql.NullInt32{Int32: 7, Valid: true})
+		assert.NoError(t, err)
+		assert.Equal(t, Some(int32(7)), result)
+	})
+
+	t.Run("invalid value becomes None", func(t *testing.T) {
+		result, err := NullToOption[int64](sql.NullInt64{})
+		assert.NoError(t, err)
+		assert.True(t, result.IsNone())
+	})
+
+	t.Run("incompatible type returns error", func(t *testing.T) {
+		_, err := NullToOption[time.Time](sql.NullString{String: "x", Valid: true})
+		assert.Error(t, err)
+	})
+
+	t.Run("valuer error is propagated", func(t *testing.T) {
+		_, err := NullToOption[string](failingValuer{})
+		assert.EqualError(t, err, "boom")
+	})
+}
+
+func TestScanAndValue(t *testing.T) {
+	t.Run("scan nil", func(t *testing.T) {
+		opt := Some("previous")
+		assert.NoError(t, opt.Scan(nil))
+		assert.True(t, opt.IsNone())
+	})
+
+	t.Run("scan bytes into string", func(t *testing.T) {
+		var opt Option[string]
+		assert.NoError(t, opt.Scan([]byte("hello")))
+		assert.Equal(t, Some("hello"), opt)
+	})
+
+	t.Run("scan bytes copies the driver buffer", func(t *testing.T) {
+		buffer := []byte("hello")
+		var opt Option[[]byte]
+		assert.NoError(t, opt.Scan(buffer))
+		copy(buffer, "jello")
+		assert.Equal(t, Some([]byte("hello")), opt)
+	})
+
+	t.Run("scan int64 into int", func(t *testing.T) {
+		var opt Option[int]
+		assert.NoError(t, opt.Scan(int64(5)))
+		assert.Equal(t, Some(5), opt)
+	})
+
+	t.Run("scan lossy numbers", func(t *testing.T) {
+		var integer Option[int]
+		assert.EqualError(t, integer.Scan(3.7), "option: 3.7 does not fit int")
+		assert.True(t, integer.IsNone())
+
+		var small Option[int8]
+		assert.EqualError(t, small.Scan(int64(300)), "option: 300 does not fit int8")
+
+		var unsigned Option[uint]
+		assert.Error(t, unsigned.Scan(int64(-1)))
+
+		assert.NoError(t, integer.Scan(2.0))
+		assert.Equal(t, Some(2), integer)
+
+		var single Option[float32]
+		assert.NoError(t, single.Scan(0.5))
+		assert.Equal(t, Some(float32(0.5)), single)
+	})
+
+	t.Run("scan unsupported type", func(t *testing.T) {
+		var opt Option[bool]
+		assert.Error(t, opt.Scan("true"))
+	})
+
+	t.Run("value", func(t *testing.T) {
+		value, err := Some(3).Value()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), value)
+
+		value, err = None[int]().Value()
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+	})
+}
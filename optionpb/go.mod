@@ -0,0 +1,15 @@
+module github.com/lumiluminousai/golang-fp-utility/optionpb
+
+go 1.22.0
+
+require (
+	github.com/lumiluminousai/golang-fp-utility v0.1.0
+	github.com/stretchr/testify v1.8.4
+	google.golang.org/protobuf v1.34.2
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,194 @@
+// Package optionpb converts between option.Option and protobuf wrapper,
+// timestamp and proto3 optional fields.
+//
+// It lives in its own module so that the core package does not depend on protobuf.
+package optionpb
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	option "github.com/lumiluminousai/golang-fp-utility/option"
+)
+
+// FromStringValue converts a *wrapperspb.StringValue into an Option; nil becomes None.
+func FromStringValue(source *wrapperspb.StringValue) option.Option[string] {
+	if source == nil {
+		return option.None[string]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToStringValue converts an Option into a *wrapperspb.StringValue; None becomes nil.
+func ToStringValue(source option.Option[string]) *wrapperspb.StringValue {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.String(value)
+}
+
+// FromBytesValue converts a *wrapperspb.BytesValue into an Option; nil becomes None.
+func FromBytesValue(source *wrapperspb.BytesValue) option.Option[[]byte] {
+	if source == nil {
+		return option.None[[]byte]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToBytesValue converts an Option into a *wrapperspb.BytesValue; None becomes nil.
+func ToBytesValue(source option.Option[[]byte]) *wrapperspb.BytesValue {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Bytes(value)
+}
+
+// FromBoolValue converts a *wrapperspb.BoolValue into an Option; nil becomes None.
+func FromBoolValue(source *wrapperspb.BoolValue) option.Option[bool] {
+	if source == nil {
+		return option.None[bool]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToBoolValue converts an Option into a *wrapperspb.BoolValue; None becomes nil.
+func ToBoolValue(source option.Option[bool]) *wrapperspb.BoolValue {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Bool(value)
+}
+
+// FromInt32Value converts a *wrapperspb.Int32Value into an Option; nil becomes None.
+func FromInt32Value(source *wrapperspb.Int32Value) option.Option[int32] {
+	if source == nil {
+		return option.None[int32]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToInt32Value converts an Option into a *wrapperspb.Int32Value; None becomes nil.
+func ToInt32Value(source option.Option[int32]) *wrapperspb.Int32Value {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Int32(value)
+}
+
+// FromInt64Value converts a *wrapperspb.Int64Value into an Option; nil becomes None.
+func FromInt64Value(source *wrapperspb.Int64Value) option.Option[int64] {
+	if source == nil {
+		return option.None[int64]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToInt64Value converts an Option into a *wrapperspb.Int64Value; None becomes nil.
+func ToInt64Value(source option.Option[int64]) *wrapperspb.Int64Value {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Int64(value)
+}
+
+// FromUInt32Value converts a *wrapperspb.UInt32Value into an Option; nil becomes None.
+func FromUInt32Value(source *wrapperspb.UInt32Value) option.Option[uint32] {
+	if source == nil {
+		return option.None[uint32]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToUInt32Value converts an Option into a *wrapperspb.UInt32Value; None becomes nil.
+func ToUInt32Value(source option.Option[uint32]) *wrapperspb.UInt32Value {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.UInt32(value)
+}
+
+// FromUInt64Value converts a *wrapperspb.UInt64Value into an Option; nil becomes None.
+func FromUInt64Value(source *wrapperspb.UInt64Value) option.Option[uint64] {
+	if source == nil {
+		return option.None[uint64]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToUInt64Value converts an Option into a *wrapperspb.UInt64Value; None becomes nil.
+func ToUInt64Value(source option.Option[uint64]) *wrapperspb.UInt64Value {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.UInt64(value)
+}
+
+// FromFloatValue converts a *wrapperspb.FloatValue into an Option; nil becomes None.
+func FromFloatValue(source *wrapperspb.FloatValue) option.Option[float32] {
+	if source == nil {
+		return option.None[float32]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToFloatValue converts an Option into a *wrapperspb.FloatValue; None becomes nil.
+func ToFloatValue(source option.Option[float32]) *wrapperspb.FloatValue {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Float(value)
+}
+
+// FromDoubleValue converts a *wrapperspb.DoubleValue into an Option; nil becomes None.
+func FromDoubleValue(source *wrapperspb.DoubleValue) option.Option[float64] {
+	if source == nil {
+		return option.None[float64]()
+	}
+	return option.Some(source.GetValue())
+}
+
+// ToDoubleValue converts an Option into a *wrapperspb.DoubleValue; None becomes nil.
+func ToDoubleValue(source option.Option[float64]) *wrapperspb.DoubleValue {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return wrapperspb.Double(value)
+}
+
+// FromTimestamp converts a *timestamppb.Timestamp into an Option; nil becomes None.
+func FromTimestamp(source *timestamppb.Timestamp) option.Option[time.Time] {
+	if source == nil {
+		return option.None[time.Time]()
+	}
+	return option.Some(source.AsTime())
+}
+
+// ToTimestamp converts an Option into a *timestamppb.Timestamp; None becomes nil.
+func ToTimestamp(source option.Option[time.Time]) *timestamppb.Timestamp {
+	value, ok := source.Get()
+	if !ok {
+		return nil
+	}
+	return timestamppb.New(value)
+}
+
+// FromOptional converts a proto3 optional field (generated as a pointer) into an Option.
+func FromOptional[T any](source *T) option.Option[T] {
+	return option.FromPointer(source)
+}
+
+// ToOptional converts an Option into a pointer suitable for a proto3 optional field.
+func ToOptional[T any](source option.Option[T]) *T {
+	return source.ToPointer()
+}
@@ -0,0 +1,51 @@
+package optionpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	option "github.com/lumiluminousai/golang-fp-utility/option"
+)
+
+func TestWrapperConversions(t *testing.T) {
+	t.Run("string value", func(t *testing.T) {
+		assert.Equal(t, option.Some("abc"), FromStringValue(wrapperspb.String("abc")))
+		assert.True(t, FromStringValue(nil).IsNone())
+		assert.Equal(t, "abc", ToStringValue(option.Some("abc")).GetValue())
+		assert.Nil(t, ToStringValue(option.None[string]()))
+	})
+
+	t.Run("numeric values", func(t *testing.T) {
+		assert.Equal(t, option.Some(int64(5)), FromInt64Value(wrapperspb.Int64(5)))
+		assert.Equal(t, option.Some(int32(5)), FromInt32Value(wrapperspb.Int32(5)))
+		assert.Equal(t, option.Some(1.5), FromDoubleValue(wrapperspb.Double(1.5)))
+		assert.Equal(t, uint64(9), ToUInt64Value(option.Some(uint64(9))).GetValue())
+		assert.Nil(t, ToFloatValue(option.None[float32]()))
+	})
+
+	t.Run("bool value keeps false as Some", func(t *testing.T) {
+		assert.Equal(t, option.Some(false), FromBoolValue(wrapperspb.Bool(false)))
+	})
+}
+
+func TestTimestampConversions(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 10, time.UTC)
+
+	assert.Equal(t, option.Some(now), FromTimestamp(timestamppb.New(now)))
+	assert.True(t, FromTimestamp(nil).IsNone())
+	assert.True(t, ToTimestamp(option.Some(now)).AsTime().Equal(now))
+	assert.Nil(t, ToTimestamp(option.None[time.Time]()))
+}
+
+func TestOptionalConversions(t *testing.T) {
+	value := "name"
+
+	assert.Equal(t, option.Some("name"), FromOptional(&value))
+	assert.True(t, FromOptional[string](nil).IsNone())
+	assert.Equal(t, "name", *ToOptional(option.Some("name")))
+	assert.Nil(t, ToOptional(option.None[string]()))
+}
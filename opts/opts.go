@@ -0,0 +1,53 @@
+// Package opts provides helpers for the functional-options configuration pattern.
+package opts
+
+import "fmt"
+
+// Option modifies a configuration value of type T.
+type Option[T any] func(*T)
+
+// Apply applies the options to cfg in order. Nil options are ignored.
+func Apply[T any](cfg *T, options ...Option[T]) {
+	for _, option := range options {
+		if option != nil {
+			option(cfg)
+		}
+	}
+}
+
+// WithDefault returns a copy of defaults with the options applied.
+// Example:
+//   - WithDefault(ServerConfig{Port: 8080}, WithPort(9090)) returns ServerConfig{Port: 9090}.
+func WithDefault[T any](defaults T, options ...Option[T]) T {
+	cfg := defaults
+	Apply(&cfg, options...)
+	return cfg
+}
+
+// Compose combines several options into one that applies them in order.
+func Compose[T any](options ...Option[T]) Option[T] {
+	return func(cfg *T) {
+		Apply(cfg, options...)
+	}
+}
+
+// When returns option when condition is true and a no-op otherwise.
+func When[T any](condition bool, option Option[T]) Option[T] {
+	if !condition {
+		return nil
+	}
+	return option
+}
+
+// Build applies the options to a copy of defaults and validates the result.
+// Each validator runs in order and the first error is returned, prefixed with "opts: ".
+func Build[T any](defaults T, options []Option[T], validators ...func(T) error) (T, error) {
+	cfg := WithDefault(defaults, options...)
+	for _, validate := range validators {
+		if err := validate(cfg); err != nil {
+			var zero T
+			return zero, fmt.Errorf("opts: %w", err)
+		}
+	}
+	return cfg, nil
+}
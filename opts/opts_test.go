@@ -0,0 +1,69 @@
+package opts
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type serverConfig struct {
+	Host    string
+	Port    int
+	Timeout time.Duration
+}
+
+func withPort(port int) Option[serverConfig] {
+	return func(c *serverConfig) { c.Port = port }
+}
+
+func withTimeout(timeout time.Duration) Option[serverConfig] {
+	return func(c *serverConfig) { c.Timeout = timeout }
+}
+
+var defaults = serverConfig{Host: "localhost", Port: 8080, Timeout: time.Second}
+
+func TestApply(t *testing.T) {
+	cfg := defaults
+	Apply(&cfg, withPort(9090), nil, withPort(9091))
+	assert.Equal(t, serverConfig{Host: "localhost", Port: 9091, Timeout: time.Second}, cfg)
+}
+
+func TestWithDefault(t *testing.T) {
+	cfg := WithDefault(defaults, withTimeout(time.Minute))
+	assert.Equal(t, time.Minute, cfg.Timeout)
+	assert.Equal(t, time.Second, defaults.Timeout)
+}
+
+func TestCompose(t *testing.T) {
+	production := Compose(withPort(443), withTimeout(30*time.Second))
+	cfg := WithDefault(defaults, production)
+	assert.Equal(t, serverConfig{Host: "localhost", Port: 443, Timeout: 30 * time.Second}, cfg)
+}
+
+func TestWhen(t *testing.T) {
+	assert.Equal(t, 9090, WithDefault(defaults, When(true, withPort(9090))).Port)
+	assert.Equal(t, 8080, WithDefault(defaults, When(false, withPort(9090))).Port)
+}
+
+func TestBuild(t *testing.T) {
+	validPort := func(c serverConfig) error {
+		if c.Port <= 0 || c.Port > 65535 {
+			return errors.New("port out of range")
+		}
+		return nil
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		cfg, err := Build(defaults, []Option[serverConfig]{withPort(9090)}, validPort)
+		assert.NoError(t, err)
+		assert.Equal(t, 9090, cfg.Port)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cfg, err := Build(defaults, []Option[serverConfig]{withPort(-1)}, validPort)
+		assert.EqualError(t, err, "opts: port out of range")
+		assert.Equal(t, serverConfig{}, cfg)
+	})
+}
@@ -0,0 +1,54 @@
+// Package reader provides Reader, a computation that depends on a shared environment.
+package reader
+
+// Reader is a computation producing an A from an environment Env, such as configuration,
+// loggers or repositories. Readers are composed first and run once the environment is known.
+type Reader[Env any, A any] func(env Env) A
+
+// Of creates a Reader that ignores the environment and returns value.
+func Of[Env any, A any](value A) Reader[Env, A] {
+	return func(Env) A {
+		return value
+	}
+}
+
+// Ask creates a Reader that returns the environment itself.
+func Ask[Env any]() Reader[Env, Env] {
+	return func(env Env) Env {
+		return env
+	}
+}
+
+// Asks creates a Reader that returns a part of the environment.
+// Example:
+//   - Asks(func(d Deps) *sql.DB { return d.DB })
+func Asks[Env any, A any](selector func(Env) A) Reader[Env, A] {
+	return Reader[Env, A](selector)
+}
+
+// Run runs the computation with env.
+func (r Reader[Env, A]) Run(env Env) A {
+	return r(env)
+}
+
+// Map transforms the result of a Reader.
+func Map[Env any, A any, B any](source Reader[Env, A], transform func(A) B) Reader[Env, B] {
+	return func(env Env) B {
+		return transform(source(env))
+	}
+}
+
+// FlatMap chains a Reader whose construction depends on the result of source.
+// Both run with the same environment.
+func FlatMap[Env any, A any, B any](source Reader[Env, A], next func(A) Reader[Env, B]) Reader[Env, B] {
+	return func(env Env) B {
+		return next(source(env))(env)
+	}
+}
+
+// Local runs source with an environment derived from the outer one, e.g. a logger with extra fields.
+func Local[Env any, A any](source Reader[Env, A], modify func(Env) Env) Reader[Env, A] {
+	return func(env Env) A {
+		return source(modify(env))
+	}
+}
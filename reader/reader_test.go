@@ -0,0 +1,49 @@
+package reader
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type deps struct {
+	Prefix string
+	Rates  map[string]float64
+}
+
+func TestReader(t *testing.T) {
+	env := deps{Prefix: "report", Rates: map[string]float64{"EUR": 1.1}}
+
+	t.Run("Of, Ask and Asks", func(t *testing.T) {
+		assert.Equal(t, 3, Of[deps](3).Run(env))
+		assert.Equal(t, env, Ask[deps]().Run(env))
+		assert.Equal(t, "report", Asks(func(d deps) string { return d.Prefix })(env))
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		length := Map(Asks(func(d deps) string { return d.Prefix }), func(s string) int { return len(s) })
+		assert.Equal(t, 6, length.Run(env))
+	})
+
+	t.Run("FlatMap composes before the environment is supplied", func(t *testing.T) {
+		convert := func(amount float64) Reader[deps, float64] {
+			return Asks(func(d deps) float64 { return amount * d.Rates["EUR"] })
+		}
+		title := func(amount float64) Reader[deps, string] {
+			return Asks(func(d deps) string { return fmt.Sprintf("%s: %.2f", d.Prefix, amount) })
+		}
+		pipeline := FlatMap(FlatMap(Of[deps](100.0), convert), title)
+
+		assert.Equal(t, "report: 110.00", pipeline.Run(env))
+		assert.Equal(t, "other: 200.00", pipeline.Run(deps{Prefix: "other", Rates: map[string]float64{"EUR": 2}}))
+	})
+
+	t.Run("Local", func(t *testing.T) {
+		prefix := Asks(func(d deps) string { return d.Prefix })
+		nested := Local(prefix, func(d deps) deps { d.Prefix += "/eu"; return d })
+
+		assert.Equal(t, "report/eu", nested.Run(env))
+		assert.Equal(t, "report", env.Prefix)
+	})
+}
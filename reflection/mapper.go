@@ -0,0 +1,331 @@
+package reflection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// ErrUnmappable is returned when a source field cannot be converted to the destination field type.
+var ErrUnmappable = errors.New("unmappable field")
+
+// FieldRule customizes how MapStruct fills one destination field.
+type FieldRule struct {
+	dest      string
+	source    string
+	transform func(value any) (any, error)
+	ignore    bool
+}
+
+// MapField fills the destination field from a differently named source field.
+func MapField(dest, source string) FieldRule {
+	return FieldRule{dest: dest, source: source}
+}
+
+// TransformField fills the destination field with transform applied to the source field value.
+// Example:
+//   - TransformField("Total", "AmountCents", func(v any) (any, error) { return float64(v.(int64)) / 100, nil })
+func TransformField(dest, source string, transform func(value any) (any, error)) FieldRule {
+	return FieldRule{dest: dest, source: source, transform: transform}
+}
+
+// IgnoreField leaves the destination field at its zero value.
+func IgnoreField(dest string) FieldRule {
+	return FieldRule{dest: dest, ignore: true}
+}
+
+// converter copies a source value into an addressable destination value.
+type converter func(dest, src reflect.Value) error
+
+type typePair struct {
+	src, dest reflect.Type
+}
+
+// converters caches compiled converters per type pair.
+var converters sync.Map
+
+// MapStruct builds a D from src. Exported fields are matched by name, nested structs, pointers,
+// slices and maps are mapped recursively, and numeric fields are converted. Rules rename, transform
+// or ignore individual top-level destination fields. Destination fields without a source stay zero.
+// Compiled mappings are cached per type pair.
+// Example:
+//   - MapStruct[UserRow, UserDTO](row, MapField("Email", "EmailAddress"), IgnoreField("Password"))
+func MapStruct[S any, D any](src S, rules ...FieldRule) (D, error) {
+	var dest D
+	srcValue := reflect.ValueOf(&src).Elem()
+	destValue := reflect.ValueOf(&dest).Elem()
+	if len(rules) == 0 {
+		if err := converterFor(srcValue.Type(), destValue.Type())(destValue, srcValue); err != nil {
+			return dest, fmt.Errorf("mapStruct: %w", err)
+		}
+		return dest, nil
+	}
+
+	if srcValue.Kind() != reflect.Struct || destValue.Kind() != reflect.Struct {
+		return dest, fmt.Errorf("mapStruct: %w: rules require struct types, got %v and %v", ErrUnmappable, srcValue.Type(), destValue.Type())
+	}
+	byDest := make(map[string]FieldRule, len(rules))
+	for _, rule := range rules {
+		if _, ok := destValue.Type().FieldByName(rule.dest); !ok {
+			return dest, fmt.Errorf("mapStruct: %w: %s", ErrUnknownField, rule.dest)
+		}
+		byDest[rule.dest] = rule
+	}
+
+	for i := 0; i < destValue.NumField(); i++ {
+		field := destValue.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		rule, hasRule := byDest[field.Name]
+		if hasRule && rule.ignore {
+			continue
+		}
+		sourceName := field.Name
+		if hasRule {
+			sourceName = rule.source
+		}
+		srcField, ok := srcValue.Type().FieldByName(sourceName)
+		if !ok || !srcField.IsExported() {
+			if hasRule {
+				return dest, fmt.Errorf("mapStruct: %w: %s", ErrUnknownField, sourceName)
+			}
+			continue
+		}
+
+		source := srcValue.FieldByIndex(srcField.Index)
+		if hasRule && rule.transform != nil {
+			transformed, err := rule.transform(source.Interface())
+			if err != nil {
+				return dest, fmt.Errorf("mapStruct: field %s: %w", field.Name, err)
+			}
+			source = reflect.ValueOf(transformed)
+			if !source.IsValid() {
+				continue
+			}
+		}
+		if err := converterFor(source.Type(), field.Type)(destValue.Field(i), source); err != nil {
+			return dest, fmt.Errorf("mapStruct: field %s: %w", field.Name, err)
+		}
+	}
+	return dest, nil
+}
+
+func converterFor(src, dest reflect.Type) converter {
+	key := typePair{src: src, dest: dest}
+	if cached, ok := converters.Load(key); ok {
+		return cached.(converter)
+	}
+	compileMu.Lock()
+	defer compileMu.Unlock()
+	return compileLocked(key)
+}
+
+// compileMu serializes compilation; pending holds the converters being compiled so recursive
+// types resolve to a forwarding converter instead of recursing forever.
+var (
+	compileMu sync.Mutex
+	pending   = make(map[typePair]*converter)
+)
+
+func compileLocked(key typePair) converter {
+	if cached, ok := converters.Load(key); ok {
+		return cached.(converter)
+	}
+	if cell, ok := pending[key]; ok {
+		return func(d, s reflect.Value) error { return (*cell)(d, s) }
+	}
+
+	cell := new(converter)
+	pending[key] = cell
+	*cell = compileConverter(key.src, key.dest)
+	delete(pending, key)
+	converters.Store(key, *cell)
+	return *cell
+}
+
+func compileConverter(src, dest reflect.Type) converter {
+	switch {
+	case src.Kind() == reflect.Ptr && dest.Kind() == reflect.Ptr && !src.AssignableTo(dest):
+		elem := compileLocked(typePair{src.Elem(), dest.Elem()})
+		return func(d, s reflect.Value) error {
+			if s.IsNil() {
+				return nil
+			}
+			target := reflect.New(dest.Elem())
+			if err := elem(target.Elem(), s.Elem()); err != nil {
+				return err
+			}
+			d.Set(target)
+			return nil
+		}
+	case src.Kind() == reflect.Ptr && dest.Kind() != reflect.Ptr:
+		elem := compileLocked(typePair{src.Elem(), dest})
+		return func(d, s reflect.Value) error {
+			if s.IsNil() {
+				return nil
+			}
+			return elem(d, s.Elem())
+		}
+	case src.Kind() != reflect.Ptr && dest.Kind() == reflect.Ptr:
+		elem := compileLocked(typePair{src, dest.Elem()})
+		return func(d, s reflect.Value) error {
+			target := reflect.New(dest.Elem())
+			if err := elem(target.Elem(), s); err != nil {
+				return err
+			}
+			d.Set(target)
+			return nil
+		}
+	case src.AssignableTo(dest) && !needsDeepMapping(src):
+		return func(d, s reflect.Value) error {
+			d.Set(s)
+			return nil
+		}
+	case src.Kind() == reflect.Struct && dest.Kind() == reflect.Struct:
+		return compileStruct(src, dest)
+	case (src.Kind() == reflect.Slice || src.Kind() == reflect.Array) && dest.Kind() == reflect.Slice:
+		elem := compileLocked(typePair{src.Elem(), dest.Elem()})
+		return func(d, s reflect.Value) error {
+			if s.Kind() == reflect.Slice && s.IsNil() {
+				return nil
+			}
+			target := reflect.MakeSlice(dest, s.Len(), s.Len())
+			for i := 0; i < s.Len(); i++ {
+				if err := elem(target.Index(i), s.Index(i)); err != nil {
+					return fmt.Errorf("index %d: %w", i, err)
+				}
+			}
+			d.Set(target)
+			return nil
+		}
+	case src.Kind() == reflect.Map && dest.Kind() == reflect.Map && src.Key().AssignableTo(dest.Key()):
+		elem := compileLocked(typePair{src.Elem(), dest.Elem()})
+		return func(d, s reflect.Value) error {
+			if s.IsNil() {
+				return nil
+			}
+			target := reflect.MakeMapWithSize(dest, s.Len())
+			iter := s.MapRange()
+			for iter.Next() {
+				value := reflect.New(dest.Elem()).Elem()
+				if err := elem(value, iter.Value()); err != nil {
+					return fmt.Errorf("key %v: %w", iter.Key(), err)
+				}
+				target.SetMapIndex(iter.Key(), value)
+			}
+			d.Set(target)
+			return nil
+		}
+	case isNumeric(src) && isNumeric(dest):
+		return func(d, s reflect.Value) error {
+			converted, ok := convertNumber(s, dest)
+			if !ok {
+				return fmt.Errorf("%w: %v does not fit %v", ErrUnmappable, s, dest)
+			}
+			d.Set(converted)
+			return nil
+		}
+	default:
+		return func(reflect.Value, reflect.Value) error {
+			return fmt.Errorf("%w: cannot map %v to %v", ErrUnmappable, src, dest)
+		}
+	}
+}
+
+// compileStruct maps exported fields by name; a field that cannot be mapped fails the whole conversion.
+func compileStruct(src, dest reflect.Type) converter {
+	type step struct {
+		name     string
+		destIdx  int
+		srcIndex []int
+		convert  converter
+	}
+	var steps []step
+	for i := 0; i < dest.NumField(); i++ {
+		field := dest.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		srcField, ok := src.FieldByName(field.Name)
+		if !ok || !srcField.IsExported() {
+			continue
+		}
+		steps = append(steps, step{
+			name:     field.Name,
+			destIdx:  i,
+			srcIndex: srcField.Index,
+			convert:  compileLocked(typePair{srcField.Type, field.Type}),
+		})
+	}
+	return func(d, s reflect.Value) error {
+		for _, st := range steps {
+			if err := st.convert(d.Field(st.destIdx), s.FieldByIndex(st.srcIndex)); err != nil {
+				return fmt.Errorf("field %s: %w", st.name, err)
+			}
+		}
+		return nil
+	}
+}
+
+// needsDeepMapping reports whether values of t share memory when assigned, so that mapping
+// an identical type should still produce an independent copy.
+func needsDeepMapping(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map:
+		return true
+	}
+	return false
+}
+
+func isNumeric(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// convertNumber converts a numeric value to dest and reports false when the conversion is lossy:
+// a fraction or out-of-range value converted to an integer, a sign change, or a float that
+// does not fit a narrower float type.
+func convertNumber(value reflect.Value, dest reflect.Type) (reflect.Value, bool) {
+	if isFloatKind(value.Kind()) && isFloatKind(dest.Kind()) {
+		return value.Convert(dest), !reflect.Zero(dest).OverflowFloat(value.Float())
+	}
+	if isFloatKind(value.Kind()) && (math.IsNaN(value.Float()) || math.IsInf(value.Float(), 0)) {
+		return reflect.Value{}, false
+	}
+	converted := value.Convert(dest)
+	if isNegative(value) != isNegative(converted) {
+		return reflect.Value{}, false
+	}
+	back := converted.Convert(value.Type())
+	switch {
+	case isFloatKind(value.Kind()):
+		return converted, back.Float() == value.Float()
+	case value.CanInt():
+		return converted, back.Int() == value.Int()
+	default:
+		return converted, back.Uint() == value.Uint()
+	}
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isNegative(value reflect.Value) bool {
+	switch {
+	case isFloatKind(value.Kind()):
+		return value.Float() < 0
+	case value.CanInt():
+		return value.Int() < 0
+	default:
+		return false
+	}
+}
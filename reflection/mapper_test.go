@@ -0,0 +1,173 @@
+package reflection
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type lineRow struct {
+	SKU      string
+	Quantity int32
+}
+
+type userRow struct {
+	ID           int64
+	Name         string
+	EmailAddress string
+	Password     string
+	Address      *address
+	Lines        []lineRow
+	Labels       map[string]lineRow
+	AmountCents  int64
+}
+
+type addressDTO struct {
+	City string
+}
+
+type lineDTO struct {
+	SKU      string
+	Quantity int
+}
+
+type userDTO struct {
+	ID       int
+	Name     string
+	Email    string
+	Password string
+	Address  addressDTO
+	Lines    []lineDTO
+	Labels   map[string]*lineDTO
+	Total    float64
+	Extra    string
+}
+
+func sampleUser() userRow {
+	return userRow{
+		ID:           7,
+		Name:         "Ann",
+		EmailAddress: "ann@example.com",
+		Password:     "secret",
+		Address:      &address{City: "Paris"},
+		Lines:        []lineRow{{"A", 1}, {"B", 2}},
+		Labels:       map[string]lineRow{"top": {"C", 3}},
+		AmountCents:  1250,
+	}
+}
+
+func TestMapStruct(t *testing.T) {
+	t.Run("auto-maps same-name fields recursively", func(t *testing.T) {
+		dto, err := MapStruct[userRow, userDTO](sampleUser())
+		assert.NoError(t, err)
+		assert.Equal(t, userDTO{
+			ID:       7,
+			Name:     "Ann",
+			Password: "secret",
+			Address:  addressDTO{City: "Paris"},
+			Lines:    []lineDTO{{"A", 1}, {"B", 2}},
+			Labels:   map[string]*lineDTO{"top": {"C", 3}},
+		}, dto)
+	})
+
+	t.Run("rules rename, transform and ignore", func(t *testing.T) {
+		dto, err := MapStruct[userRow, userDTO](sampleUser(),
+			MapField("Email", "EmailAddress"),
+			IgnoreField("Password"),
+			TransformField("Total", "AmountCents", func(v any) (any, error) {
+				return float64(v.(int64)) / 100, nil
+			}),
+			TransformField("Name", "Name", func(v any) (any, error) {
+				return strings.ToUpper(v.(string)), nil
+			}),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, "ann@example.com", dto.Email)
+		assert.Equal(t, "", dto.Password)
+		assert.Equal(t, 12.5, dto.Total)
+		assert.Equal(t, "ANN", dto.Name)
+		assert.Equal(t, []lineDTO{{"A", 1}, {"B", 2}}, dto.Lines)
+	})
+
+	t.Run("nil pointers and slices stay zero", func(t *testing.T) {
+		dto, err := MapStruct[userRow, userDTO](userRow{Name: "Bob"})
+		assert.NoError(t, err)
+		assert.Equal(t, userDTO{Name: "Bob"}, dto)
+	})
+
+	t.Run("mapped slices do not alias the source", func(t *testing.T) {
+		source := []lineRow{{"A", 1}}
+		copied, err := MapStruct[[]lineRow, []lineRow](source)
+		assert.NoError(t, err)
+		copied[0].Quantity = 5
+		assert.Equal(t, int32(1), source[0].Quantity)
+	})
+
+	t.Run("recursive types", func(t *testing.T) {
+		type nodeRow struct {
+			Value    int
+			Children []nodeRow
+		}
+		type nodeDTO struct {
+			Value    int64
+			Children []nodeDTO
+		}
+		dto, err := MapStruct[nodeRow, nodeDTO](nodeRow{1, []nodeRow{{2, nil}, {3, []nodeRow{{4, nil}}}}})
+		assert.NoError(t, err)
+		assert.Equal(t, nodeDTO{1, []nodeDTO{{2, nil}, {3, []nodeDTO{{4, nil}}}}}, dto)
+	})
+
+	t.Run("transform error", func(t *testing.T) {
+		_, err := MapStruct[userRow, userDTO](sampleUser(), TransformField("Total", "AmountCents", func(any) (any, error) {
+			return nil, errors.New("negative amount")
+		}))
+		assert.EqualError(t, err, "mapStruct: field Total: negative amount")
+	})
+
+	t.Run("unknown rule fields", func(t *testing.T) {
+		_, err := MapStruct[userRow, userDTO](sampleUser(), MapField("Missing", "Name"))
+		assert.True(t, errors.Is(err, ErrUnknownField))
+		_, err = MapStruct[userRow, userDTO](sampleUser(), MapField("Email", "Missing"))
+		assert.EqualError(t, err, "mapStruct: unknown field: Missing")
+	})
+
+	t.Run("unmappable field", func(t *testing.T) {
+		type source struct{ ID string }
+		type target struct{ ID int }
+		_, err := MapStruct[source, target](source{ID: "7"})
+		assert.True(t, errors.Is(err, ErrUnmappable))
+		assert.EqualError(t, err, "mapStruct: field ID: unmappable field: cannot map string to int")
+
+		_, err = MapStruct[source, target](source{ID: "7"}, IgnoreField("ID"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("lossy numeric field", func(t *testing.T) {
+		type source struct{ Count int64 }
+		type target struct{ Count int8 }
+		_, err := MapStruct[source, target](source{Count: 300})
+		assert.True(t, errors.Is(err, ErrUnmappable))
+		assert.EqualError(t, err, "mapStruct: field Count: unmappable field: 300 does not fit int8")
+
+		mapped, err := MapStruct[source, target](source{Count: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, target{Count: 100}, mapped)
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				dto, err := MapStruct[userRow, userDTO](sampleUser())
+				assert.NoError(t, err)
+				assert.Equal(t, 7, dto.ID)
+			}()
+		}
+		wg.Wait()
+	})
+}
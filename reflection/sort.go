@@ -0,0 +1,262 @@
+package reflection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInvalidSortSpec is returned when a sort spec is not "Field", "Field asc" or "Field desc".
+	ErrInvalidSortSpec = errors.New("invalid sort spec")
+	// ErrUnknownField is returned when a sort spec names a field the element type does not have.
+	ErrUnknownField = errors.New("unknown field")
+	// ErrUnsupportedKind is returned when a sort field is not a number, string, bool or time.Time.
+	ErrUnsupportedKind = errors.New("unsupported field kind")
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// sortField is one compiled level of a sort spec.
+type sortField struct {
+	index []int
+	desc  bool
+}
+
+// maxCachedFields bounds fieldCache, since field paths can be nested without limit through recursive types.
+const maxCachedFields = 1024
+
+type fieldCacheKey struct {
+	typ  reflect.Type
+	path string
+}
+
+type resolvedField struct {
+	index     []int
+	fieldType reflect.Type
+}
+
+// fieldCache holds resolved field paths per struct type under their exact field names, so
+// variations in case, spacing or order of untrusted sort specs do not add entries.
+var fieldCache = struct {
+	sync.RWMutex
+	fields map[fieldCacheKey]resolvedField
+}{fields: make(map[fieldCacheKey]resolvedField)}
+
+// SortByFields stably sorts a slice of structs (or pointers to structs) in place by the fields
+// named in specs. Each spec is a comma-separated list of "Field [asc|desc]" entries; nested fields
+// use dots and names fall back to a case-insensitive match.
+// Example:
+//   - SortByFields(orders, "CustomerCode asc, Amount desc")
+//   - SortByFields(orders, "customer.code", "amount desc")
+func SortByFields[T any](slice []T, specs ...string) error {
+	elemType := reflect.TypeOf((*T)(nil)).Elem()
+	fields, err := compileSortSpec(elemType, strings.Join(specs, ","))
+	if err != nil {
+		return fmt.Errorf("sortByFields: %w", err)
+	}
+
+	sort.SliceStable(slice, func(i, j int) bool {
+		a, b := reflect.ValueOf(&slice[i]).Elem(), reflect.ValueOf(&slice[j]).Elem()
+		for _, field := range fields {
+			c := compareValues(fieldByIndex(a, field.index), fieldByIndex(b, field.index))
+			if c == 0 {
+				continue
+			}
+			if field.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+	return nil
+}
+
+func compileSortSpec(elemType reflect.Type, spec string) ([]sortField, error) {
+	structType := elemType
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %v is not a struct", ErrUnsupportedKind, elemType)
+	}
+
+	var fields []sortField
+	for _, entry := range strings.Split(spec, ",") {
+		parts := strings.Fields(entry)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSortSpec, strings.TrimSpace(entry))
+		}
+
+		field := sortField{}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				field.desc = true
+			default:
+				return nil, fmt.Errorf("%w: %q", ErrInvalidSortSpec, strings.TrimSpace(entry))
+			}
+		}
+
+		index, fieldType, err := cachedResolveField(structType, parts[0])
+		if err != nil {
+			return nil, err
+		}
+		if !sortableType(fieldType) {
+			return nil, fmt.Errorf("%w: field %s is %v", ErrUnsupportedKind, parts[0], fieldType)
+		}
+		field.index = index
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// cachedResolveField resolves path through fieldCache. Only paths spelled exactly like the
+// field names are cached, and nothing more is stored once the cache is full.
+func cachedResolveField(structType reflect.Type, path string) ([]int, reflect.Type, error) {
+	key := fieldCacheKey{typ: structType, path: path}
+	fieldCache.RLock()
+	cached, ok := fieldCache.fields[key]
+	fieldCache.RUnlock()
+	if ok {
+		return cached.index, cached.fieldType, nil
+	}
+
+	index, fieldType, canonical, err := resolveField(structType, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if canonical == path {
+		fieldCache.Lock()
+		if len(fieldCache.fields) < maxCachedFields {
+			fieldCache.fields[key] = resolvedField{index: index, fieldType: fieldType}
+		}
+		fieldCache.Unlock()
+	}
+	return index, fieldType, nil
+}
+
+// resolveField follows a dotted field path and returns the index path, the final field type
+// and the path spelled with the actual field names.
+func resolveField(structType reflect.Type, path string) ([]int, reflect.Type, string, error) {
+	var index []int
+	var names []string
+	current := structType
+	for _, name := range strings.Split(path, ".") {
+		if current.Kind() == reflect.Ptr {
+			current = current.Elem()
+		}
+		if current.Kind() != reflect.Struct {
+			return nil, nil, "", fmt.Errorf("%w: %s", ErrUnknownField, path)
+		}
+		field, ok := current.FieldByName(name)
+		if !ok {
+			field, ok = current.FieldByNameFunc(func(candidate string) bool {
+				return strings.EqualFold(candidate, name)
+			})
+		}
+		if !ok || !field.IsExported() {
+			return nil, nil, "", fmt.Errorf("%w: %s", ErrUnknownField, path)
+		}
+		// Each level is recorded separately so pointers between levels can be dereferenced.
+		index = append(index, len(field.Index))
+		index = append(index, field.Index...)
+		names = append(names, field.Name)
+		current = field.Type
+	}
+	return index, current, strings.Join(names, "."), nil
+}
+
+func sortableType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// fieldByIndex walks the length-prefixed index path built by resolveField. It returns an invalid
+// Value when a nil pointer is met along the way.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i := 0; i < len(index); {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		n := index[i]
+		v = v.FieldByIndex(index[i+1 : i+1+n])
+		i += 1 + n
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// compareValues orders two field values of the same sortable type. Missing values (nil pointers)
+// sort before present ones.
+func compareValues(a, b reflect.Value) int {
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return 0
+	case !a.IsValid():
+		return -1
+	case !b.IsValid():
+		return 1
+	}
+
+	if a.Type() == timeType {
+		return a.Interface().(time.Time).Compare(b.Interface().(time.Time))
+	}
+	switch a.Kind() {
+	case reflect.Bool:
+		return compareOrdered(boolRank(a.Bool()), boolRank(b.Bool()))
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareOrdered(a.Uint(), b.Uint())
+	default:
+		return compareOrdered(a.Float(), b.Float())
+	}
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func compareOrdered[T int | int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
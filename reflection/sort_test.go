@@ -0,0 +1,121 @@
+package reflection
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortByFields(t *testing.T) {
+	type Customer struct {
+		Code string
+	}
+	type SalesOrder struct {
+		CustomerCode string
+		Amount       float64
+		Priority     int
+		Urgent       bool
+		CreatedAt    time.Time
+		Customer     *Customer
+		notes        string
+	}
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := func() []SalesOrder {
+		return []SalesOrder{
+			{CustomerCode: "C2", Amount: 200, Priority: 2, CreatedAt: base.Add(3 * time.Hour), Customer: &Customer{"b"}},
+			{CustomerCode: "C1", Amount: 300, Priority: 1, Urgent: true, CreatedAt: base.Add(time.Hour), Customer: &Customer{"a"}},
+			{CustomerCode: "C2", Amount: 400, Priority: 1, CreatedAt: base},
+			{CustomerCode: "C1", Amount: 100, Priority: 2, CreatedAt: base.Add(2 * time.Hour), Customer: &Customer{"c"}},
+		}
+	}
+	amounts := func(orders []SalesOrder) []float64 {
+		result := make([]float64, len(orders))
+		for i, o := range orders {
+			result[i] = o.Amount
+		}
+		return result
+	}
+
+	t.Run("asc and desc in one spec", func(t *testing.T) {
+		orders := source()
+		err := SortByFields(orders, "CustomerCode asc, Amount desc")
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{300, 100, 400, 200}, amounts(orders))
+	})
+
+	t.Run("multiple specs and default direction", func(t *testing.T) {
+		orders := source()
+		err := SortByFields(orders, "priority", "amount DESC")
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{400, 300, 200, 100}, amounts(orders))
+	})
+
+	t.Run("bool and time fields", func(t *testing.T) {
+		orders := source()
+		assert.NoError(t, SortByFields(orders, "Urgent desc, CreatedAt"))
+		assert.Equal(t, []float64{300, 400, 100, 200}, amounts(orders))
+	})
+
+	t.Run("nested field through pointer sorts nil first", func(t *testing.T) {
+		orders := source()
+		assert.NoError(t, SortByFields(orders, "Customer.Code"))
+		assert.Equal(t, []float64{400, 300, 200, 100}, amounts(orders))
+	})
+
+	t.Run("slice of pointers", func(t *testing.T) {
+		orders := source()
+		pointers := []*SalesOrder{&orders[0], &orders[1], &orders[2], &orders[3]}
+		assert.NoError(t, SortByFields(pointers, "Amount"))
+		assert.Equal(t, 100.0, pointers[0].Amount)
+		assert.Equal(t, 400.0, pointers[3].Amount)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		err := SortByFields(source(), "Missing asc")
+		assert.True(t, errors.Is(err, ErrUnknownField))
+		assert.Equal(t, "sortByFields: unknown field: Missing", err.Error())
+	})
+
+	t.Run("unexported field", func(t *testing.T) {
+		err := SortByFields(source(), "notes")
+		assert.True(t, errors.Is(err, ErrUnknownField))
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		err := SortByFields(source(), "Customer")
+		assert.True(t, errors.Is(err, ErrUnsupportedKind))
+	})
+
+	t.Run("invalid direction", func(t *testing.T) {
+		err := SortByFields(source(), "Amount sideways")
+		assert.True(t, errors.Is(err, ErrInvalidSortSpec))
+	})
+
+	t.Run("empty entry", func(t *testing.T) {
+		err := SortByFields(source(), "Amount,")
+		assert.True(t, errors.Is(err, ErrInvalidSortSpec))
+	})
+
+	t.Run("non-struct elements", func(t *testing.T) {
+		err := SortByFields([]int{3, 1}, "Value")
+		assert.True(t, errors.Is(err, ErrUnsupportedKind))
+	})
+
+	t.Run("spelling variations of a spec share cached fields", func(t *testing.T) {
+		countCached := func() int {
+			fieldCache.RLock()
+			defer fieldCache.RUnlock()
+			return len(fieldCache.fields)
+		}
+		assert.NoError(t, SortByFields(source(), "Amount desc, Priority"))
+		before := countCached()
+
+		for _, spec := range []string{"amount desc,priority", "PRIORITY ,  AMOUNT desc", "Priority asc, amount DESC", "Amount desc, Priority"} {
+			orders := source()
+			assert.NoError(t, SortByFields(orders, spec))
+		}
+		assert.Equal(t, before, countCached())
+	})
+}
@@ -0,0 +1,143 @@
+package reflection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrTypeMismatch is returned when a new field value cannot be assigned to the field.
+var ErrTypeMismatch = errors.New("type mismatch")
+
+// With deep-copies value and applies the mutators to the copy, leaving the original untouched.
+// Pointers, slices, maps and arrays reachable through exported fields are copied, so mutators
+// may change nested data freely; unexported fields are copied shallowly.
+// Example:
+//   - updated := With(order, func(o *Order) { o.Status = "shipped"; o.Items[0].Qty = 2 })
+func With[T any](value T, mutators ...func(*T)) T {
+	copied := DeepCopy(value)
+	for _, mutate := range mutators {
+		mutate(&copied)
+	}
+	return copied
+}
+
+// WithField deep-copies value and sets the field at the dotted path to newValue.
+// Nil pointers along the path are allocated in the copy. newValue must be assignable or
+// convertible to the field type.
+// Example:
+//   - WithField(order, "Customer.Address.City", "Berlin")
+func WithField[T any](value T, path string, newValue any) (T, error) {
+	copied := DeepCopy(value)
+	target := reflect.ValueOf(&copied).Elem()
+	for _, name := range strings.Split(path, ".") {
+		for target.Kind() == reflect.Ptr {
+			if target.IsNil() {
+				target.Set(reflect.New(target.Type().Elem()))
+			}
+			target = target.Elem()
+		}
+		if target.Kind() != reflect.Struct {
+			return value, fmt.Errorf("withField: %w: %s", ErrUnknownField, path)
+		}
+		field, ok := target.Type().FieldByName(name)
+		if !ok || !field.IsExported() {
+			return value, fmt.Errorf("withField: %w: %s", ErrUnknownField, path)
+		}
+		target = target.FieldByIndex(field.Index)
+	}
+
+	replacement := reflect.ValueOf(newValue)
+	switch {
+	case !replacement.IsValid():
+		target.Set(reflect.Zero(target.Type()))
+	case replacement.Type().AssignableTo(target.Type()):
+		target.Set(replacement)
+	case isNumeric(replacement.Type()) && isNumeric(target.Type()):
+		converted, ok := convertNumber(replacement, target.Type())
+		if !ok {
+			return value, fmt.Errorf("withField: %w: %v does not fit %s of type %v", ErrTypeMismatch, replacement, path, target.Type())
+		}
+		target.Set(converted)
+	case replacement.Type().ConvertibleTo(target.Type()) && replacement.Kind() != reflect.String && target.Kind() != reflect.String:
+		target.Set(replacement.Convert(target.Type()))
+	default:
+		return value, fmt.Errorf("withField: %w: cannot assign %v to %s of type %v", ErrTypeMismatch, replacement.Type(), path, target.Type())
+	}
+	return copied, nil
+}
+
+// DeepCopy returns a copy of value that shares no pointers, slices or maps with the original
+// through exported fields. Shared and cyclic pointers keep their shape in the copy.
+func DeepCopy[T any](value T) T {
+	source := reflect.ValueOf(&value).Elem()
+	target := reflect.New(source.Type()).Elem()
+	copyValue(target, source, make(map[copyKey]reflect.Value))
+	return target.Interface().(T)
+}
+
+type copyKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func copyValue(target, source reflect.Value, seen map[copyKey]reflect.Value) {
+	switch source.Kind() {
+	case reflect.Ptr:
+		if source.IsNil() {
+			return
+		}
+		key := copyKey{source.Pointer(), source.Type()}
+		if existing, ok := seen[key]; ok {
+			target.Set(existing)
+			return
+		}
+		copied := reflect.New(source.Type().Elem())
+		seen[key] = copied
+		copyValue(copied.Elem(), source.Elem(), seen)
+		target.Set(copied)
+	case reflect.Slice:
+		if source.IsNil() {
+			return
+		}
+		copied := reflect.MakeSlice(source.Type(), source.Len(), source.Len())
+		for i := 0; i < source.Len(); i++ {
+			copyValue(copied.Index(i), source.Index(i), seen)
+		}
+		target.Set(copied)
+	case reflect.Array:
+		for i := 0; i < source.Len(); i++ {
+			copyValue(target.Index(i), source.Index(i), seen)
+		}
+	case reflect.Map:
+		if source.IsNil() {
+			return
+		}
+		copied := reflect.MakeMapWithSize(source.Type(), source.Len())
+		iter := source.MapRange()
+		for iter.Next() {
+			element := reflect.New(source.Type().Elem()).Elem()
+			copyValue(element, iter.Value(), seen)
+			copied.SetMapIndex(iter.Key(), element)
+		}
+		target.Set(copied)
+	case reflect.Struct:
+		// Copy the whole struct first so unexported fields keep their (shallow) values.
+		target.Set(source)
+		for i := 0; i < source.NumField(); i++ {
+			if source.Type().Field(i).IsExported() {
+				copyValue(target.Field(i), source.Field(i), seen)
+			}
+		}
+	case reflect.Interface:
+		if source.IsNil() {
+			return
+		}
+		element := reflect.New(source.Elem().Type()).Elem()
+		copyValue(element, source.Elem(), seen)
+		target.Set(element)
+	default:
+		target.Set(source)
+	}
+}
@@ -0,0 +1,146 @@
+package reflection
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	City string
+}
+
+type customer struct {
+	Name    string
+	Address *address
+}
+
+type item struct {
+	SKU string
+	Qty int
+}
+
+type salesOrder struct {
+	ID       int
+	Customer customer
+	Items    []item
+	Tags     map[string]string
+	Note     any
+	secret   string
+}
+
+func sampleOrder() salesOrder {
+	return salesOrder{
+		ID:       1,
+		Customer: customer{Name: "Ann", Address: &address{City: "Paris"}},
+		Items:    []item{{"A", 1}, {"B", 2}},
+		Tags:     map[string]string{"channel": "web"},
+		Note:     []string{"fragile"},
+		secret:   "s",
+	}
+}
+
+func TestWith(t *testing.T) {
+	t.Run("mutators change only the copy", func(t *testing.T) {
+		original := sampleOrder()
+		updated := With(original, func(o *salesOrder) {
+			o.Customer.Address.City = "Berlin"
+			o.Items[0].Qty = 10
+			o.Tags["channel"] = "store"
+			o.Note.([]string)[0] = "handle with care"
+		}, func(o *salesOrder) {
+			o.ID = 2
+		})
+
+		assert.Equal(t, sampleOrder(), original)
+		assert.Equal(t, 2, updated.ID)
+		assert.Equal(t, "Berlin", updated.Customer.Address.City)
+		assert.Equal(t, 10, updated.Items[0].Qty)
+		assert.Equal(t, "store", updated.Tags["channel"])
+		assert.Equal(t, []string{"handle with care"}, updated.Note)
+		assert.Equal(t, "s", updated.secret)
+	})
+
+	t.Run("no mutators returns an equal copy", func(t *testing.T) {
+		assert.Equal(t, sampleOrder(), With(sampleOrder()))
+	})
+}
+
+func TestDeepCopy(t *testing.T) {
+	t.Run("shared pointers stay shared", func(t *testing.T) {
+		shared := &address{City: "Rome"}
+		pair := [2]*address{shared, shared}
+		copied := DeepCopy(pair)
+		assert.Same(t, copied[0], copied[1])
+		assert.NotSame(t, shared, copied[0])
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		type node struct {
+			Next *node
+		}
+		loop := &node{}
+		loop.Next = loop
+		copied := DeepCopy(loop)
+		assert.Same(t, copied, copied.Next)
+		assert.NotSame(t, loop, copied)
+	})
+}
+
+func TestWithField(t *testing.T) {
+	t.Run("nested field through pointer", func(t *testing.T) {
+		original := sampleOrder()
+		updated, err := WithField(original, "Customer.Address.City", "Berlin")
+		assert.NoError(t, err)
+		assert.Equal(t, "Berlin", updated.Customer.Address.City)
+		assert.Equal(t, "Paris", original.Customer.Address.City)
+	})
+
+	t.Run("allocates nil pointers", func(t *testing.T) {
+		updated, err := WithField(salesOrder{}, "Customer.Address.City", "Oslo")
+		assert.NoError(t, err)
+		assert.Equal(t, "Oslo", updated.Customer.Address.City)
+	})
+
+	t.Run("converts numeric values", func(t *testing.T) {
+		updated, err := WithField(sampleOrder(), "ID", int64(7))
+		assert.NoError(t, err)
+		assert.Equal(t, 7, updated.ID)
+	})
+
+	t.Run("lossy numeric values", func(t *testing.T) {
+		_, err := WithField(sampleOrder(), "ID", 3.7)
+		assert.True(t, errors.Is(err, ErrTypeMismatch))
+		assert.Equal(t, "withField: type mismatch: 3.7 does not fit ID of type int", err.Error())
+
+		_, err = WithField(sampleOrder(), "ID", uint64(math.MaxUint64))
+		assert.True(t, errors.Is(err, ErrTypeMismatch))
+	})
+
+	t.Run("nil resets to zero", func(t *testing.T) {
+		updated, err := WithField(sampleOrder(), "Tags", nil)
+		assert.NoError(t, err)
+		assert.Nil(t, updated.Tags)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		original := sampleOrder()
+		updated, err := WithField(original, "Customer.Phone", "123")
+		assert.True(t, errors.Is(err, ErrUnknownField))
+		assert.Equal(t, "withField: unknown field: Customer.Phone", err.Error())
+		assert.Equal(t, original, updated)
+	})
+
+	t.Run("unexported field", func(t *testing.T) {
+		_, err := WithField(sampleOrder(), "secret", "x")
+		assert.True(t, errors.Is(err, ErrUnknownField))
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		_, err := WithField(sampleOrder(), "ID", "seven")
+		assert.True(t, errors.Is(err, ErrTypeMismatch))
+		assert.Equal(t, "withField: type mismatch: cannot assign string to ID of type int", err.Error())
+	})
+}
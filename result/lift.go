@@ -0,0 +1,58 @@
+package result
+
+import "fmt"
+
+// Must returns value, or panics when err is not nil. It is meant for initialization code where
+// an error is a programming mistake, e.g. Must(regexp.Compile(pattern)).
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(fmt.Sprintf("must: %v", err))
+	}
+	return value
+}
+
+// FromTuple converts an idiomatic (value, error) pair into a Result.
+func FromTuple[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// Resultify lifts a function returning (value, error) into one returning a Result.
+// Example:
+//   - collection.Map(inputs, Resultify(strconv.Atoi)) returns []Result[int].
+func Resultify[A any, B any](fn func(A) (B, error)) func(A) Result[B] {
+	return func(a A) Result[B] {
+		return FromTuple(fn(a))
+	}
+}
+
+// Lift2 adapts a plain two-parameter function to Results: the result is Ok only when both inputs
+// are, otherwise it carries the first error.
+// Example:
+//   - Lift2(func(a, b int) int { return a + b })(Ok(1), Ok(2)) returns Ok(3).
+func Lift2[A any, B any, C any](fn func(A, B) C) func(Result[A], Result[B]) Result[C] {
+	return func(a Result[A], b Result[B]) Result[C] {
+		if a.err != nil {
+			return Err[C](a.err)
+		}
+		if b.err != nil {
+			return Err[C](b.err)
+		}
+		return Ok(fn(a.value, b.value))
+	}
+}
+
+// Lift3 adapts a plain three-parameter function to Results: the result is Ok only when all inputs
+// are, otherwise it carries the first error.
+func Lift3[A any, B any, C any, D any](fn func(A, B, C) D) func(Result[A], Result[B], Result[C]) Result[D] {
+	return func(a Result[A], b Result[B], c Result[C]) Result[D] {
+		for _, err := range []error{a.err, b.err, c.err} {
+			if err != nil {
+				return Err[D](err)
+			}
+		}
+		return Ok(fn(a.value, b.value, c.value))
+	}
+}
@@ -0,0 +1,51 @@
+package result
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMust(t *testing.T) {
+	assert.Equal(t, 42, Must(strconv.Atoi("42")))
+	assert.PanicsWithValue(t, "must: boom", func() { Must(0, errors.New("boom")) })
+}
+
+func TestFromTuple(t *testing.T) {
+	ok := FromTuple(1, nil)
+	assert.True(t, ok.IsOk())
+
+	failed := FromTuple(1, errors.New("boom"))
+	value, err := failed.ToTuple()
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, 0, value)
+}
+
+func TestResultify(t *testing.T) {
+	atoi := Resultify(strconv.Atoi)
+
+	value, err := atoi("7").ToTuple()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, value)
+
+	assert.True(t, atoi("x").IsErr())
+}
+
+func TestLift2(t *testing.T) {
+	add := Lift2(func(a, b int) int { return a + b })
+	first, second := errors.New("first"), errors.New("second")
+
+	assert.Equal(t, Ok(3), add(Ok(1), Ok(2)))
+	assert.Equal(t, Err[int](first), add(Err[int](first), Err[int](second)))
+	assert.Equal(t, Err[int](second), add(Ok(1), Err[int](second)))
+}
+
+func TestLift3(t *testing.T) {
+	sum := Lift3(func(a, b, c int) int { return a + b + c })
+	boom := errors.New("boom")
+
+	assert.Equal(t, Ok(6), sum(Ok(1), Ok(2), Ok(3)))
+	assert.Equal(t, Err[int](boom), sum(Ok(1), Ok(2), Err[int](boom)))
+}
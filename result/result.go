@@ -0,0 +1,83 @@
+// Package result provides a Result type holding either a value or an error.
+package result
+
+import "fmt"
+
+// Result holds either a successful value or an error.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok wraps a successful value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err wraps an error.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// IsOk reports whether the result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// ToTuple converts the result back into the idiomatic (value, error) pair.
+func (r Result[T]) ToTuple() (T, error) {
+	return r.value, r.err
+}
+
+// Error returns the wrapped error, or nil for an Ok result.
+func (r Result[T]) Error() error {
+	return r.err
+}
+
+// Unwrap returns the value and panics when the result holds an error.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("unwrap: %v", r.err))
+	}
+	return r.value
+}
+
+// UnwrapOr returns the value, or fallback when the result holds an error.
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.value
+}
+
+// OrElse returns the result itself when it is Ok, and recoverFn(err) otherwise.
+func (r Result[T]) OrElse(recoverFn func(err error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return recoverFn(r.err)
+}
+
+// Map applies transform to the value of an Ok result and passes errors through.
+func Map[T1 any, T2 any](source Result[T1], transform func(T1) T2) Result[T2] {
+	if source.err != nil {
+		return Err[T2](source.err)
+	}
+	return Ok(transform(source.value))
+}
+
+// AndThen chains a fallible step onto an Ok result and passes errors through,
+// so a pipeline stops at the first failure.
+// Example:
+//   - AndThen(FromTuple(strconv.Atoi(s)), validateAge)
+func AndThen[T1 any, T2 any](source Result[T1], next func(T1) Result[T2]) Result[T2] {
+	if source.err != nil {
+		return Err[T2](source.err)
+	}
+	return next(source.value)
+}
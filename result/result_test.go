@@ -0,0 +1,68 @@
+package result
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOkAndErr(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(5)
+
+		value, err := r.ToTuple()
+		assert.True(t, r.IsOk())
+		assert.False(t, r.IsErr())
+		assert.Equal(t, 5, value)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		r := Err[int](errors.New("boom"))
+
+		value, err := r.ToTuple()
+		assert.True(t, r.IsErr())
+		assert.Equal(t, 0, value)
+		assert.EqualError(t, err, "boom")
+	})
+}
+
+func TestUnwrap(t *testing.T) {
+	boom := errors.New("boom")
+
+	assert.Equal(t, 1, Ok(1).Unwrap())
+	assert.PanicsWithValue(t, "unwrap: boom", func() { Err[int](boom).Unwrap() })
+
+	assert.Equal(t, 1, Ok(1).UnwrapOr(9))
+	assert.Equal(t, 9, Err[int](boom).UnwrapOr(9))
+
+	assert.NoError(t, Ok(1).Error())
+	assert.Equal(t, boom, Err[int](boom).Error())
+}
+
+func TestOrElse(t *testing.T) {
+	fallback := func(err error) Result[int] { return Ok(0) }
+	assert.Equal(t, Ok(1), Ok(1).OrElse(fallback))
+	assert.Equal(t, Ok(0), Err[int](errors.New("boom")).OrElse(fallback))
+}
+
+func TestMapAndThen(t *testing.T) {
+	boom := errors.New("boom")
+	double := func(n int) int { return n * 2 }
+
+	assert.Equal(t, Ok(4), Map(Ok(2), double))
+	assert.Equal(t, Err[int](boom), Map(Err[int](boom), double))
+
+	parse := func(s string) Result[int] { return FromTuple(strconv.Atoi(s)) }
+	positive := func(n int) Result[int] {
+		if n <= 0 {
+			return Err[int](errors.New("not positive"))
+		}
+		return Ok(n)
+	}
+	assert.Equal(t, Ok(5), AndThen(parse("5"), positive))
+	assert.EqualError(t, AndThen(parse("-5"), positive).Error(), "not positive")
+	assert.True(t, AndThen(parse("x"), positive).IsErr())
+}
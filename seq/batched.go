@@ -0,0 +1,78 @@
+package seq
+
+import "fmt"
+
+// BatchedOptions configures BatchedWithOptions.
+type BatchedOptions struct {
+	// Prefetch fetches the next batch in the background while the current one is consumed.
+	Prefetch bool
+}
+
+// Batched lazily reads a source in offset/limit batches of batchSize, such as a paged database
+// query. Reading stops at the first batch shorter than batchSize, when the consumer stops, or when
+// fetch fails; the returned function reports the fetch error once the sequence has been consumed.
+// A batchSize below 1 is treated as 1.
+// Example:
+//   - rows, errFn := Batched(func(offset, limit int) ([]Row, error) { return repo.List(ctx, offset, limit) }, 500)
+func Batched[T any](fetch func(offset, limit int) ([]T, error), batchSize int) (Seq[T], func() error) {
+	return BatchedWithOptions(fetch, batchSize, BatchedOptions{})
+}
+
+type batch[T any] struct {
+	items []T
+	err   error
+}
+
+// BatchedWithOptions is Batched with options. With Prefetch, at most one batch is fetched ahead;
+// if the consumer stops early, that batch is discarded when its fetch returns.
+func BatchedWithOptions[T any](fetch func(offset, limit int) ([]T, error), batchSize int, opts BatchedOptions) (Seq[T], func() error) {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	var fetchErr error
+
+	start := func(offset int) <-chan batch[T] {
+		// Buffered so an abandoned prefetch does not block its goroutine.
+		result := make(chan batch[T], 1)
+		if opts.Prefetch {
+			go func() {
+				items, err := fetch(offset, batchSize)
+				result <- batch[T]{items: items, err: err}
+			}()
+		} else {
+			items, err := fetch(offset, batchSize)
+			result <- batch[T]{items: items, err: err}
+		}
+		return result
+	}
+
+	sequence := func(yield func(T) bool) {
+		fetchErr = nil
+		offset := 0
+		pending := start(offset)
+		for {
+			current := <-pending
+			if current.err != nil {
+				fetchErr = fmt.Errorf("batched: offset %d: %w", offset, current.err)
+				return
+			}
+			hasMore := len(current.items) >= batchSize
+			offset += len(current.items)
+			if hasMore && opts.Prefetch {
+				pending = start(offset)
+			}
+			for _, item := range current.items {
+				if !yield(item) {
+					return
+				}
+			}
+			if !hasMore {
+				return
+			}
+			if !opts.Prefetch {
+				pending = start(offset)
+			}
+		}
+	}
+	return sequence, func() error { return fetchErr }
+}
@@ -0,0 +1,108 @@
+package seq
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// table simulates a paged query over the numbers 0..size-1 and records the requested offsets.
+type table struct {
+	mu      sync.Mutex
+	size    int
+	offsets []int
+	failAt  int
+}
+
+func (tb *table) fetch(offset, limit int) ([]int, error) {
+	tb.mu.Lock()
+	tb.offsets = append(tb.offsets, offset)
+	tb.mu.Unlock()
+	if tb.failAt > 0 && offset >= tb.failAt {
+		return nil, errors.New("connection reset")
+	}
+	var rows []int
+	for i := offset; i < offset+limit && i < tb.size; i++ {
+		rows = append(rows, i)
+	}
+	return rows, nil
+}
+
+func TestBatched(t *testing.T) {
+	t.Run("reads every batch", func(t *testing.T) {
+		tb := &table{size: 7}
+		sequence, errFn := Batched(tb.fetch, 3)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, sequence.Collect())
+		assert.NoError(t, errFn())
+		assert.Equal(t, []int{0, 3, 6}, tb.offsets)
+	})
+
+	t.Run("exact multiple needs one empty batch", func(t *testing.T) {
+		tb := &table{size: 6}
+		sequence, _ := Batched(tb.fetch, 3)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, sequence.Collect())
+		assert.Equal(t, []int{0, 3, 6}, tb.offsets)
+	})
+
+	t.Run("fetches lazily", func(t *testing.T) {
+		tb := &table{size: 100}
+		sequence, _ := Batched(tb.fetch, 10)
+		count := 0
+		sequence(func(int) bool {
+			count++
+			return count < 5
+		})
+		assert.Equal(t, []int{0}, tb.offsets)
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		tb := &table{size: 10, failAt: 4}
+		sequence, errFn := Batched(tb.fetch, 4)
+		assert.Equal(t, []int{0, 1, 2, 3}, sequence.Collect())
+		assert.EqualError(t, errFn(), "batched: offset 4: connection reset")
+	})
+
+	t.Run("batch size below 1", func(t *testing.T) {
+		tb := &table{size: 2}
+		sequence, _ := Batched(tb.fetch, 0)
+		assert.Equal(t, []int{0, 1}, sequence.Collect())
+	})
+}
+
+func TestBatchedWithPrefetch(t *testing.T) {
+	t.Run("reads every batch", func(t *testing.T) {
+		tb := &table{size: 7}
+		sequence, errFn := BatchedWithOptions(tb.fetch, 3, BatchedOptions{Prefetch: true})
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, sequence.Collect())
+		assert.NoError(t, errFn())
+		assert.Equal(t, []int{0, 3, 6}, tb.offsets)
+	})
+
+	t.Run("next batch is requested before the current one is consumed", func(t *testing.T) {
+		requested := make(chan int, 10)
+		fetch := func(offset, limit int) ([]int, error) {
+			requested <- offset
+			if offset >= 4 {
+				return nil, nil
+			}
+			return []int{offset, offset + 1}, nil
+		}
+		sequence, _ := BatchedWithOptions(fetch, 2, BatchedOptions{Prefetch: true})
+		sequence(func(item int) bool {
+			if item == 0 {
+				assert.Equal(t, 0, <-requested)
+				assert.Equal(t, 2, <-requested)
+			}
+			return true
+		})
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		tb := &table{size: 10, failAt: 4}
+		sequence, errFn := BatchedWithOptions(tb.fetch, 4, BatchedOptions{Prefetch: true})
+		assert.Equal(t, []int{0, 1, 2, 3}, sequence.Collect())
+		assert.EqualError(t, errFn(), "batched: offset 4: connection reset")
+	})
+}
@@ -0,0 +1,182 @@
+package seq
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	result "github.com/lumiluminousai/golang-fp-utility/result"
+)
+
+// FromCSV lazily decodes CSV records from r into typed rows.
+// Decoding and malformed-record errors are yielded as Err results prefixed with the 1-based row number,
+// and reading continues with the next row. An I/O error is yielded once and ends the sequence.
+func FromCSV[T any](r io.Reader, decode func(record []string) (T, error)) Seq[result.Result[T]] {
+	return func(yield func(result.Result[T]) bool) {
+		readCSV(csv.NewReader(r), 0, decode, yield)
+	}
+}
+
+// FromCSVWithHeader lazily binds CSV rows to the struct type T using the first record as header.
+// Columns are matched to exported fields by their `csv:"name"` tag or, without a tag,
+// case-insensitively by field name; unmatched columns are ignored and a `csv:"-"` tag skips a field.
+// Supported field kinds are strings, booleans, integers, floats and encoding.TextUnmarshaler implementations.
+func FromCSVWithHeader[T any](r io.Reader) Seq[result.Result[T]] {
+	return func(yield func(result.Result[T]) bool) {
+		reader := csv.NewReader(r)
+		header, err := reader.Read()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			yield(result.Err[T](fmt.Errorf("fromCSV: row 1: %w", err)))
+			return
+		}
+		binder, err := newCSVBinder[T](header)
+		if err != nil {
+			yield(result.Err[T](err))
+			return
+		}
+		readCSV(reader, 1, binder, yield)
+	}
+}
+
+func readCSV[T any](reader *csv.Reader, row int, decode func(record []string) (T, error), yield func(result.Result[T]) bool) {
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return
+		}
+		row++
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !yield(result.Err[T](fmt.Errorf("fromCSV: row %d: %w", row, err))) || !errors.As(err, &parseErr) {
+				return
+			}
+			continue
+		}
+		value, err := decode(record)
+		if err != nil {
+			if !yield(result.Err[T](fmt.Errorf("fromCSV: row %d: %w", row, err))) {
+				return
+			}
+			continue
+		}
+		if !yield(result.Ok(value)) {
+			return
+		}
+	}
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// csvColumn binds one header column to a struct field.
+type csvColumn struct {
+	index int
+	field int
+}
+
+// newCSVBinder builds a decode function assigning header columns to struct fields of T.
+// Columns are decoded in header order, and two columns matching the same field are rejected.
+func newCSVBinder[T any](header []string) (func(record []string) (T, error), error) {
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("fromCSV: %v is not a struct", structType)
+	}
+
+	var columns []csvColumn
+	boundBy := map[int]string{} // field index -> header name
+	for col, name := range header {
+		name = strings.TrimSpace(name)
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			tag := field.Tag.Get("csv")
+			if tag == "-" {
+				continue
+			}
+			if (tag != "" && tag == name) || (tag == "" && strings.EqualFold(field.Name, name)) {
+				if !csvFieldSupported(field.Type) {
+					return nil, fmt.Errorf("fromCSV: field %s has unsupported type %v", field.Name, field.Type)
+				}
+				if previous, ok := boundBy[i]; ok {
+					return nil, fmt.Errorf("fromCSV: columns %q and %q both match field %s", previous, name, field.Name)
+				}
+				boundBy[i] = name
+				columns = append(columns, csvColumn{index: col, field: i})
+				break
+			}
+		}
+	}
+
+	return func(record []string) (T, error) {
+		var value T
+		target := reflect.ValueOf(&value).Elem()
+		for _, column := range columns {
+			if column.index >= len(record) {
+				continue
+			}
+			if err := setCSVField(target.Field(column.field), record[column.index]); err != nil {
+				return value, fmt.Errorf("column %q: %w", header[column.index], err)
+			}
+		}
+		return value, nil
+	}, nil
+}
+
+func csvFieldSupported(fieldType reflect.Type) bool {
+	if reflect.PointerTo(fieldType).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch fieldType.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func setCSVField(field reflect.Value, raw string) error {
+	if field.Addr().Type().Implements(textUnmarshalerType) {
+		return field.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(parsed)
+	}
+	return nil
+}
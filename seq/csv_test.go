@@ -0,0 +1,118 @@
+package seq
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	result "github.com/lumiluminousai/golang-fp-utility/result"
+)
+
+func TestFromCSV(t *testing.T) {
+	type order struct {
+		code   string
+		amount int
+	}
+	decode := func(record []string) (order, error) {
+		if len(record) != 2 {
+			return order{}, errors.New("expected 2 columns")
+		}
+		amount, err := strconv.Atoi(record[1])
+		if err != nil {
+			return order{}, err
+		}
+		return order{code: record[0], amount: amount}, nil
+	}
+
+	t.Run("decodes rows", func(t *testing.T) {
+		rows := FromCSV(strings.NewReader("A,1\nB,2\n"), decode).Collect()
+
+		assert.Equal(t, []result.Result[order]{
+			result.Ok(order{code: "A", amount: 1}),
+			result.Ok(order{code: "B", amount: 2}),
+		}, rows)
+	})
+
+	t.Run("reports row numbers and continues", func(t *testing.T) {
+		rows := FromCSV(strings.NewReader("A,1\nB,x\nC\nD,4\n"), decode).Collect()
+
+		assert.Len(t, rows, 4)
+		_, err := rows[1].ToTuple()
+		assert.ErrorContains(t, err, "fromCSV: row 2:")
+		_, err = rows[2].ToTuple()
+		assert.EqualError(t, err, "fromCSV: row 3: expected 2 columns")
+		assert.Equal(t, result.Ok(order{code: "D", amount: 4}), rows[3])
+	})
+
+	t.Run("stops early", func(t *testing.T) {
+		count := 0
+		FromCSV(strings.NewReader("A,1\nB,2\nC,3\n"), decode)(func(row result.Result[order]) bool {
+			count++
+			return count < 2
+		})
+		assert.Equal(t, 2, count)
+	})
+}
+
+func TestFromCSVWithHeader(t *testing.T) {
+	type sale struct {
+		CustomerCode string `csv:"customer_code"`
+		Amount       float64
+		Paid         bool
+		Date         time.Time
+		Internal     string `csv:"-"`
+	}
+
+	t.Run("binds columns by tag and name", func(t *testing.T) {
+		input := "customer_code,amount,PAID,date,internal,extra\nC1,10.5,true,2024-01-02T00:00:00Z,secret,x\n"
+		rows := FromCSVWithHeader[sale](strings.NewReader(input)).Collect()
+
+		assert.Equal(t, []result.Result[sale]{result.Ok(sale{
+			CustomerCode: "C1",
+			Amount:       10.5,
+			Paid:         true,
+			Date:         time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		})}, rows)
+	})
+
+	t.Run("conversion errors name the row and column", func(t *testing.T) {
+		rows := FromCSVWithHeader[sale](strings.NewReader("amount\n1\nabc\n")).Collect()
+
+		assert.Len(t, rows, 2)
+		assert.True(t, rows[0].IsOk())
+		_, err := rows[1].ToTuple()
+		assert.ErrorContains(t, err, `fromCSV: row 3: column "amount"`)
+	})
+
+	t.Run("the first failing column is reported", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			rows := FromCSVWithHeader[sale](strings.NewReader("amount,paid\nx,y\n")).Collect()
+			_, err := rows[0].ToTuple()
+			assert.ErrorContains(t, err, `column "amount"`)
+		}
+	})
+
+	t.Run("two columns matching one field", func(t *testing.T) {
+		rows := FromCSVWithHeader[sale](strings.NewReader("Amount,amount\n1,2\n")).Collect()
+
+		assert.Len(t, rows, 1)
+		_, err := rows[0].ToTuple()
+		assert.EqualError(t, err, `fromCSV: columns "Amount" and "amount" both match field Amount`)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		assert.Empty(t, FromCSVWithHeader[sale](strings.NewReader("")).Collect())
+	})
+
+	t.Run("unsupported field type", func(t *testing.T) {
+		type invalid struct{ Tags []string }
+		rows := FromCSVWithHeader[invalid](strings.NewReader("tags\na\n")).Collect()
+
+		assert.Len(t, rows, 1)
+		assert.True(t, rows[0].IsErr())
+	})
+}
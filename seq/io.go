@@ -0,0 +1,48 @@
+package seq
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	result "github.com/lumiluminousai/golang-fp-utility/result"
+)
+
+// Lines lazily reads r line by line, without the trailing "\n" or "\r\n".
+// Reading stops silently on the first read error; use LinesResult to observe it.
+// The sequence consumes the reader and can therefore be iterated only once.
+func Lines(r io.Reader) Seq[string] {
+	return func(yield func(string) bool) {
+		LinesResult(r)(func(line result.Result[string]) bool {
+			value, err := line.ToTuple()
+			if err != nil {
+				return false
+			}
+			return yield(value)
+		})
+	}
+}
+
+// LinesResult lazily reads r line by line like Lines, yielding a final Err result
+// when the reader fails with anything other than io.EOF.
+func LinesResult(r io.Reader) Seq[result.Result[string]] {
+	return func(yield func(result.Result[string]) bool) {
+		reader := bufio.NewReader(r)
+		for {
+			line, err := reader.ReadString('\n')
+			if line != "" {
+				line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+				if !yield(result.Ok(line)) {
+					return
+				}
+			}
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				yield(result.Err[string](err))
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package seq
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	result "github.com/lumiluminousai/golang-fp-utility/result"
+)
+
+// failingReader returns its content and then a non-EOF error.
+type failingReader struct {
+	content io.Reader
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	n, err := f.content.Read(p)
+	if err == io.EOF {
+		return n, errors.New("disk failure")
+	}
+	return n, err
+}
+
+func TestLines(t *testing.T) {
+	t.Run("splits lines and trims line endings", func(t *testing.T) {
+		lines := Lines(strings.NewReader("a\r\nb\n\nc")).Collect()
+		assert.Equal(t, []string{"a", "b", "", "c"}, lines)
+	})
+
+	t.Run("empty reader", func(t *testing.T) {
+		assert.Equal(t, []string{}, Lines(strings.NewReader("")).Collect())
+	})
+
+	t.Run("reads lazily", func(t *testing.T) {
+		reader := strings.NewReader(strings.Repeat("line\n", 10000))
+		Lines(reader)(func(line string) bool { return false })
+
+		assert.Greater(t, reader.Len(), 0)
+	})
+
+	t.Run("stops silently on read errors", func(t *testing.T) {
+		lines := Lines(&failingReader{content: strings.NewReader("a\nb\n")}).Collect()
+		assert.Equal(t, []string{"a", "b"}, lines)
+	})
+}
+
+func TestLinesResult(t *testing.T) {
+	t.Run("surfaces read errors", func(t *testing.T) {
+		lines := LinesResult(&failingReader{content: strings.NewReader("a\nb")}).Collect()
+
+		assert.Len(t, lines, 3)
+		assert.Equal(t, result.Ok("a"), lines[0])
+		assert.Equal(t, result.Ok("b"), lines[1])
+		_, err := lines[2].ToTuple()
+		assert.EqualError(t, err, "disk failure")
+	})
+
+	t.Run("no error at EOF", func(t *testing.T) {
+		lines := LinesResult(strings.NewReader("a\n")).Collect()
+		assert.Equal(t, []result.Result[string]{result.Ok("a")}, lines)
+	})
+}
@@ -0,0 +1,53 @@
+//go:build go1.23
+
+package seq
+
+import (
+	"iter"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+// FromSeq converts a standard library iterator, such as maps.Keys or slices.Values, into a Seq.
+func FromSeq[T any](source iter.Seq[T]) Seq[T] {
+	return Seq[T](source)
+}
+
+// ToSeq converts a Seq into a standard library iterator that can be ranged over or passed to
+// functions such as slices.Collect.
+func ToSeq[T any](source Seq[T]) iter.Seq[T] {
+	return iter.Seq[T](source)
+}
+
+// FromSeq2 converts a key/value iterator, such as maps.All, into a Seq of pairs.
+func FromSeq2[K any, V any](source iter.Seq2[K, V]) Seq[tuple.Pair[K, V]] {
+	return func(yield func(tuple.Pair[K, V]) bool) {
+		source(func(key K, value V) bool {
+			return yield(tuple.NewPair(key, value))
+		})
+	}
+}
+
+// ToSeq2 converts a Seq of pairs into a key/value iterator, e.g. for maps.Collect.
+func ToSeq2[K any, V any](source Seq[tuple.Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		source(func(pair tuple.Pair[K, V]) bool {
+			return yield(pair.First, pair.Second)
+		})
+	}
+}
+
+// MapSeq lazily transforms the values of a standard library iterator.
+func MapSeq[T1 any, T2 any](source iter.Seq[T1], transform func(T1) T2) iter.Seq[T2] {
+	return ToSeq(Map(FromSeq(source), transform))
+}
+
+// FilterSeq lazily keeps the values of a standard library iterator satisfying the predicate.
+func FilterSeq[T any](source iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return ToSeq(Filter(FromSeq(source), predicate))
+}
+
+// ReduceSeq consumes a standard library iterator, folding its values into an accumulator.
+func ReduceSeq[T any, R any](source iter.Seq[T], reduceFunc func(acc R, item T) R, initialValue R) R {
+	return Reduce(FromSeq(source), reduceFunc, initialValue)
+}
@@ -0,0 +1,50 @@
+//go:build go1.23
+
+package seq
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tuple "github.com/lumiluminousai/golang-fp-utility/tuple"
+)
+
+func TestStdlibIterators(t *testing.T) {
+	t.Run("FromSeq and ToSeq", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, FromSeq(slices.Values([]int{1, 2, 3})).Collect())
+		assert.Equal(t, []int{2, 4}, slices.Collect(ToSeq(Of(1, 2, 3, 4).Filter(func(n int) bool { return n%2 == 0 }))))
+
+		sum := 0
+		for n := range ToSeq(Range(0, 4)) {
+			sum += n
+		}
+		assert.Equal(t, 6, sum)
+	})
+
+	t.Run("FromSeq2 and ToSeq2", func(t *testing.T) {
+		source := map[string]int{"a": 1, "b": 2}
+		pairs := FromSeq2(maps.All(source)).Collect()
+		assert.ElementsMatch(t, []tuple.Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}}, pairs)
+		assert.Equal(t, source, maps.Collect(ToSeq2(From(pairs))))
+	})
+
+	t.Run("MapSeq, FilterSeq and ReduceSeq", func(t *testing.T) {
+		values := slices.Values([]int{1, 2, 3, 4})
+		squares := MapSeq(FilterSeq(values, func(n int) bool { return n > 1 }), func(n int) int { return n * n })
+		assert.Equal(t, []int{4, 9, 16}, slices.Collect(squares))
+		assert.Equal(t, 29, ReduceSeq(squares, func(acc, n int) int { return acc + n }, 0))
+	})
+
+	t.Run("breaking out of a range stops the source", func(t *testing.T) {
+		pulled := 0
+		for n := range MapSeq(ToSeq(Iterate(1, func(n int) int { return n + 1 }).Peek(func(int) { pulled++ })), func(n int) int { return n * 10 }) {
+			if n >= 30 {
+				break
+			}
+		}
+		assert.Equal(t, 3, pulled)
+	})
+}
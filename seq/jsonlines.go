@@ -0,0 +1,76 @@
+package seq
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	result "github.com/lumiluminousai/golang-fp-utility/result"
+)
+
+// ErrorPolicy decides how a streaming source handles a record it cannot decode.
+type ErrorPolicy int
+
+const (
+	// Abort ends the sequence at the first failing record.
+	Abort ErrorPolicy = iota
+	// Skip drops failing records and continues; only read errors are reported.
+	Skip
+	// Collect drops failing records, continues, and reports every failure at the end.
+	Collect
+)
+
+// FromJSONLines lazily decodes newline-delimited JSON from r into values of T; blank lines are ignored.
+// The returned function reports the errors encountered once the sequence has been consumed,
+// according to the policy. Errors are prefixed with the 1-based line number.
+func FromJSONLines[T any](r io.Reader, policy ErrorPolicy) (Seq[T], func() error) {
+	var errs []error
+	sequence := func(yield func(T) bool) {
+		errs = nil
+		lineNumber := 0
+		LinesResult(r)(func(line result.Result[string]) bool {
+			text, err := line.ToTuple()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("fromJSONLines: %w", err))
+				return false
+			}
+			lineNumber++
+			if strings.TrimSpace(text) == "" {
+				return true
+			}
+
+			var value T
+			if err := json.Unmarshal([]byte(text), &value); err != nil {
+				switch policy {
+				case Skip:
+					return true
+				case Collect:
+					errs = append(errs, fmt.Errorf("fromJSONLines: line %d: %w", lineNumber, err))
+					return true
+				default:
+					errs = append(errs, fmt.Errorf("fromJSONLines: line %d: %w", lineNumber, err))
+					return false
+				}
+			}
+			return yield(value)
+		})
+	}
+	return sequence, func() error { return errors.Join(errs...) }
+}
+
+// ToJSONLines writes every value of the sequence to w as one JSON document per line.
+// It stops at the first encoding or write error.
+func ToJSONLines[T any](source Seq[T], w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	var err error
+	source(func(item T) bool {
+		err = encoder.Encode(item)
+		return err == nil
+	})
+	if err != nil {
+		return fmt.Errorf("toJSONLines: %w", err)
+	}
+	return nil
+}
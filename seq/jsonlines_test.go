@@ -0,0 +1,86 @@
+package seq
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type event struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+const eventLines = `{"id":1,"name":"a"}
+not json
+
+{"id":3,"name":"c"}
+{"id":"x"}
+{"id":5,"name":"e"}
+`
+
+func TestFromJSONLines(t *testing.T) {
+	t.Run("abort stops at first bad line", func(t *testing.T) {
+		events, errs := FromJSONLines[event](strings.NewReader(eventLines), Abort)
+
+		assert.Equal(t, []event{{ID: 1, Name: "a"}}, events.Collect())
+		assert.ErrorContains(t, errs(), "fromJSONLines: line 2:")
+	})
+
+	t.Run("skip drops bad lines silently", func(t *testing.T) {
+		events, errs := FromJSONLines[event](strings.NewReader(eventLines), Skip)
+
+		assert.Equal(t, []event{{ID: 1, Name: "a"}, {ID: 3, Name: "c"}, {ID: 5, Name: "e"}}, events.Collect())
+		assert.NoError(t, errs())
+	})
+
+	t.Run("collect reports every bad line", func(t *testing.T) {
+		events, errs := FromJSONLines[event](strings.NewReader(eventLines), Collect)
+
+		assert.Len(t, events.Collect(), 3)
+		err := errs()
+		assert.ErrorContains(t, err, "line 2:")
+		assert.ErrorContains(t, err, "line 5:")
+	})
+
+	t.Run("read errors are always reported", func(t *testing.T) {
+		events, errs := FromJSONLines[event](&failingReader{content: strings.NewReader(`{"id":1}` + "\n")}, Skip)
+
+		assert.Equal(t, []event{{ID: 1}}, events.Collect())
+		assert.EqualError(t, errs(), "fromJSONLines: disk failure")
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("closed")
+}
+
+func TestToJSONLines(t *testing.T) {
+	t.Run("writes one document per line", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := ToJSONLines(From([]event{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}), &buf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n", buf.String())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		var buf bytes.Buffer
+		source := []event{{ID: 7, Name: "x"}}
+		assert.NoError(t, ToJSONLines(From(source), &buf))
+
+		events, errs := FromJSONLines[event](&buf, Abort)
+		assert.Equal(t, source, events.Collect())
+		assert.NoError(t, errs())
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		err := ToJSONLines(From([]int{1, 2}), failingWriter{})
+		assert.EqualError(t, err, "toJSONLines: closed")
+	})
+}
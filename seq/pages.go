@@ -0,0 +1,34 @@
+package seq
+
+import "fmt"
+
+// CursorPages lazily walks a cursor-paginated source, fetching the next page only when the
+// previous one has been consumed. The first call receives the zero cursor; fetching stops when
+// hasMore is false, the consumer stops, or fetch fails. The returned function reports the fetch
+// error once the sequence has been consumed. Each iteration starts again from the first page.
+// Example:
+//   - users, errFn := CursorPages(func(token string) ([]User, string, bool, error) { return client.ListUsers(token) })
+func CursorPages[T any, C any](fetch func(cursor C) (items []T, next C, hasMore bool, err error)) (Seq[T], func() error) {
+	var fetchErr error
+	sequence := func(yield func(T) bool) {
+		fetchErr = nil
+		var cursor C
+		for page := 1; ; page++ {
+			items, next, hasMore, err := fetch(cursor)
+			if err != nil {
+				fetchErr = fmt.Errorf("cursorPages: page %d: %w", page, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item) {
+					return
+				}
+			}
+			if !hasMore {
+				return
+			}
+			cursor = next
+		}
+	}
+	return sequence, func() error { return fetchErr }
+}
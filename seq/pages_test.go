@@ -0,0 +1,62 @@
+package seq
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorPages(t *testing.T) {
+	pages := map[string]struct {
+		items []int
+		next  string
+	}{
+		"":  {[]int{1, 2}, "b"},
+		"b": {[]int{3, 4}, "c"},
+		"c": {[]int{5}, ""},
+	}
+	var cursors []string
+	fetch := func(cursor string) ([]int, string, bool, error) {
+		cursors = append(cursors, cursor)
+		page := pages[cursor]
+		return page.items, page.next, page.next != "", nil
+	}
+
+	t.Run("walks every page", func(t *testing.T) {
+		cursors = nil
+		sequence, errFn := CursorPages(fetch)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, sequence.Collect())
+		assert.NoError(t, errFn())
+		assert.Equal(t, []string{"", "b", "c"}, cursors)
+	})
+
+	t.Run("fetches lazily", func(t *testing.T) {
+		cursors = nil
+		sequence, _ := CursorPages(fetch)
+		var taken []int
+		sequence(func(item int) bool {
+			taken = append(taken, item)
+			return len(taken) < 2
+		})
+		assert.Equal(t, []int{1, 2}, taken)
+		assert.Equal(t, []string{""}, cursors)
+	})
+
+	t.Run("composes with Filter", func(t *testing.T) {
+		sequence, _ := CursorPages(fetch)
+		odd := Filter(sequence, func(n int) bool { return n%2 == 1 })
+		assert.Equal(t, []int{1, 3, 5}, odd.Collect())
+	})
+
+	t.Run("fetch error ends the sequence", func(t *testing.T) {
+		sequence, errFn := CursorPages(func(cursor int) ([]int, int, bool, error) {
+			if cursor == 1 {
+				return nil, 0, false, errors.New("timeout")
+			}
+			return []int{cursor}, cursor + 1, true, nil
+		})
+		assert.Equal(t, []int{0}, sequence.Collect())
+		assert.EqualError(t, errFn(), "cursorPages: page 2: timeout")
+	})
+}
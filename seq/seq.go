@@ -0,0 +1,248 @@
+// Package seq provides lazy sequences that are evaluated only when consumed.
+package seq
+
+import (
+	option "github.com/lumiluminousai/golang-fp-utility/option"
+)
+
+// Seq is a lazy sequence of values. Calling it pushes values to yield
+// until the sequence is exhausted or yield returns false.
+// The shape matches iter.Seq so sequences can be ranged over on newer Go versions.
+type Seq[T any] func(yield func(T) bool)
+
+// From creates a sequence over the items of a slice.
+func From[T any](source []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range source {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Of creates a sequence over the given values.
+func Of[T any](values ...T) Seq[T] {
+	return From(values)
+}
+
+// Iterate creates an infinite sequence seed, next(seed), next(next(seed)), ...
+// Combine it with Take or TakeWhile to bound it.
+func Iterate[T any](seed T, next func(T) T) Seq[T] {
+	return func(yield func(T) bool) {
+		for value := seed; yield(value); value = next(value) {
+		}
+	}
+}
+
+// Range creates the sequence of integers from start up to, but not including, end.
+func Range(start, end int) Seq[int] {
+	return func(yield func(int) bool) {
+		for i := start; i < end; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence of the values satisfying the predicate.
+func Filter[T any](source Seq[T], predicate func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		source(func(item T) bool {
+			if !predicate(item) {
+				return true
+			}
+			return yield(item)
+		})
+	}
+}
+
+// Map returns a sequence of the transformed values. Use the Map method when the type does not change.
+func Map[T1 any, T2 any](source Seq[T1], transform func(T1) T2) Seq[T2] {
+	return func(yield func(T2) bool) {
+		source(func(item T1) bool {
+			return yield(transform(item))
+		})
+	}
+}
+
+// FlatMap returns the concatenation of the sequences produced by transform.
+func FlatMap[T1 any, T2 any](source Seq[T1], transform func(T1) Seq[T2]) Seq[T2] {
+	return func(yield func(T2) bool) {
+		source(func(item T1) bool {
+			more := true
+			transform(item)(func(inner T2) bool {
+				more = yield(inner)
+				return more
+			})
+			return more
+		})
+	}
+}
+
+// Distinct returns a sequence skipping values already seen.
+func Distinct[T comparable](source Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]bool)
+		source(func(item T) bool {
+			if seen[item] {
+				return true
+			}
+			seen[item] = true
+			return yield(item)
+		})
+	}
+}
+
+// Concat returns a sequence of the values of every sequence in order.
+func Concat[T any](sequences ...Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, s := range sequences {
+			more := true
+			s(func(item T) bool {
+				more = yield(item)
+				return more
+			})
+			if !more {
+				return
+			}
+		}
+	}
+}
+
+// Reduce evaluates the sequence, folding its values into an accumulator. The arguments follow
+// the order of collection.Fold.
+func Reduce[T any, R any](source Seq[T], reduceFunc func(acc R, item T) R, initialValue R) R {
+	acc := initialValue
+	source(func(item T) bool {
+		acc = reduceFunc(acc, item)
+		return true
+	})
+	return acc
+}
+
+// Filter returns a sequence of the values satisfying the predicate.
+func (s Seq[T]) Filter(predicate func(T) bool) Seq[T] {
+	return Filter(s, predicate)
+}
+
+// Map returns a sequence of the transformed values of the same type.
+// Use the package-level Map to change the element type.
+func (s Seq[T]) Map(transform func(T) T) Seq[T] {
+	return Map(s, transform)
+}
+
+// Take returns a sequence of at most n values.
+func (s Seq[T]) Take(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		s(func(item T) bool {
+			taken++
+			return yield(item) && taken < n
+		})
+	}
+}
+
+// Skip returns a sequence without its first n values.
+func (s Seq[T]) Skip(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		s(func(item T) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+			return yield(item)
+		})
+	}
+}
+
+// TakeWhile returns the leading values satisfying the predicate.
+func (s Seq[T]) TakeWhile(predicate func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		s(func(item T) bool {
+			return predicate(item) && yield(item)
+		})
+	}
+}
+
+// DropWhile returns the values after the leading run satisfying the predicate.
+func (s Seq[T]) DropWhile(predicate func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		s(func(item T) bool {
+			if dropping && predicate(item) {
+				return true
+			}
+			dropping = false
+			return yield(item)
+		})
+	}
+}
+
+// Peek calls action for each value as it flows through the sequence.
+func (s Seq[T]) Peek(action func(T)) Seq[T] {
+	return func(yield func(T) bool) {
+		s(func(item T) bool {
+			action(item)
+			return yield(item)
+		})
+	}
+}
+
+// ForEach evaluates the sequence, calling action for each value.
+func (s Seq[T]) ForEach(action func(T)) {
+	s(func(item T) bool {
+		action(item)
+		return true
+	})
+}
+
+// Count evaluates the sequence and returns the number of values.
+func (s Seq[T]) Count() int {
+	count := 0
+	s(func(T) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// First returns the first value, evaluating only as much of the sequence as needed.
+func (s Seq[T]) First() option.Option[T] {
+	first := option.None[T]()
+	s(func(item T) bool {
+		first = option.Some(item)
+		return false
+	})
+	return first
+}
+
+// Find returns the first value satisfying the predicate.
+func (s Seq[T]) Find(predicate func(T) bool) option.Option[T] {
+	return s.Filter(predicate).First()
+}
+
+// Any reports whether some value satisfies the predicate, stopping at the first match.
+func (s Seq[T]) Any(predicate func(T) bool) bool {
+	return s.Find(predicate).IsSome()
+}
+
+// All reports whether every value satisfies the predicate, stopping at the first mismatch.
+func (s Seq[T]) All(predicate func(T) bool) bool {
+	return !s.Any(func(item T) bool { return !predicate(item) })
+}
+
+// Collect evaluates the sequence and returns its values as a slice.
+func (s Seq[T]) Collect() []T {
+	result := []T{}
+	s(func(item T) bool {
+		result = append(result, item)
+		return true
+	})
+	return result
+}
@@ -0,0 +1,130 @@
+package seq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromAndCollect(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, From([]int{1, 2, 3}).Collect())
+	})
+
+	t.Run("nil slice", func(t *testing.T) {
+		assert.Equal(t, []int{}, From([]int(nil)).Collect())
+	})
+
+	t.Run("stops when yield returns false", func(t *testing.T) {
+		visited := []int{}
+		From([]int{1, 2, 3})(func(item int) bool {
+			visited = append(visited, item)
+			return item < 2
+		})
+		assert.Equal(t, []int{1, 2}, visited)
+	})
+}
+
+func TestFilter(t *testing.T) {
+	evens := Filter(From([]int{1, 2, 3, 4}), func(item int) bool { return item%2 == 0 })
+	assert.Equal(t, []int{2, 4}, evens.Collect())
+}
+
+func TestConstructors(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, Of("a", "b").Collect())
+	assert.Equal(t, []int{2, 3, 4}, Range(2, 5).Collect())
+	assert.Equal(t, []int{}, Range(5, 2).Collect())
+	assert.Equal(t, []int{1, 2, 4, 8}, Iterate(1, func(n int) int { return n * 2 }).Take(4).Collect())
+}
+
+func TestPipeline(t *testing.T) {
+	t.Run("chained operations", func(t *testing.T) {
+		result := From([]int{1, 2, 3, 4, 5, 6, 7, 8}).
+			Filter(func(n int) bool { return n%2 == 0 }).
+			Map(func(n int) int { return n * 10 }).
+			Take(3).
+			Collect()
+		assert.Equal(t, []int{20, 40, 60}, result)
+	})
+
+	t.Run("evaluates lazily", func(t *testing.T) {
+		evaluated := 0
+		result := Iterate(1, func(n int) int { return n + 1 }).
+			Peek(func(int) { evaluated++ }).
+			Filter(func(n int) bool { return n%3 == 0 }).
+			Take(2).
+			Collect()
+		assert.Equal(t, []int{3, 6}, result)
+		assert.Equal(t, 6, evaluated)
+	})
+
+	t.Run("Take zero does not evaluate", func(t *testing.T) {
+		evaluated := 0
+		From([]int{1, 2}).Peek(func(int) { evaluated++ }).Take(0).Collect()
+		assert.Equal(t, 0, evaluated)
+	})
+
+	t.Run("Skip, TakeWhile and DropWhile", func(t *testing.T) {
+		source := Of(1, 2, 3, 4, 1)
+		assert.Equal(t, []int{3, 4, 1}, source.Skip(2).Collect())
+		assert.Equal(t, []int{1, 2}, source.TakeWhile(func(n int) bool { return n < 3 }).Collect())
+		assert.Equal(t, []int{3, 4, 1}, source.DropWhile(func(n int) bool { return n < 3 }).Collect())
+	})
+
+	t.Run("sequences can be evaluated more than once", func(t *testing.T) {
+		pipeline := Of(1, 2, 3).Skip(1).Take(1)
+		assert.Equal(t, []int{2}, pipeline.Collect())
+		assert.Equal(t, []int{2}, pipeline.Collect())
+	})
+}
+
+func TestTypeChangingOperations(t *testing.T) {
+	t.Run("Map", func(t *testing.T) {
+		lengths := Map(Of("a", "bb", "ccc"), func(s string) int { return len(s) })
+		assert.Equal(t, []int{1, 2, 3}, lengths.Collect())
+	})
+
+	t.Run("FlatMap", func(t *testing.T) {
+		repeated := FlatMap(Of(1, 2, 3), func(n int) Seq[int] { return Of(n, n) })
+		assert.Equal(t, []int{1, 1, 2, 2}, repeated.Take(4).Collect())
+		assert.Equal(t, []int{1, 1, 2, 2, 3, 3}, repeated.Collect())
+	})
+
+	t.Run("Distinct", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, Distinct(Of(1, 2, 1, 3, 2)).Collect())
+	})
+
+	t.Run("Concat", func(t *testing.T) {
+		joined := Concat(Of(1, 2), Of[int](), Of(3))
+		assert.Equal(t, []int{1, 2, 3}, joined.Collect())
+		assert.Equal(t, []int{1, 2}, joined.Take(2).Collect())
+	})
+
+	t.Run("Reduce", func(t *testing.T) {
+		total := Reduce(Range(1, 5), func(acc, n int) int { return acc + n }, 0)
+		assert.Equal(t, 10, total)
+	})
+}
+
+func TestTerminalOperations(t *testing.T) {
+	source := Of(3, 5, 8, 13)
+
+	var seen []int
+	source.ForEach(func(n int) { seen = append(seen, n) })
+	assert.Equal(t, []int{3, 5, 8, 13}, seen)
+
+	assert.Equal(t, 4, source.Count())
+
+	first, ok := source.First().Get()
+	assert.True(t, ok)
+	assert.Equal(t, 3, first)
+	assert.True(t, Of[int]().First().IsNone())
+
+	even, ok := source.Find(func(n int) bool { return n%2 == 0 }).Get()
+	assert.True(t, ok)
+	assert.Equal(t, 8, even)
+
+	assert.True(t, source.Any(func(n int) bool { return n > 10 }))
+	assert.False(t, source.All(func(n int) bool { return n%2 == 1 }))
+	assert.True(t, Iterate(0, func(n int) int { return n + 1 }).Any(func(n int) bool { return n == 100 }))
+}
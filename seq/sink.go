@@ -0,0 +1,107 @@
+package seq
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+)
+
+// WriteTo encodes every value of the sequence and writes it to w, returning the number of bytes written.
+// It stops at the first encoding or write error.
+func WriteTo[T any](source Seq[T], w io.Writer, encode func(item T) ([]byte, error)) (int64, error) {
+	var (
+		written int64
+		items   int
+		err     error
+	)
+	source(func(item T) bool {
+		var data []byte
+		data, err = encode(item)
+		if err != nil {
+			err = fmt.Errorf("writeTo: encode item %d: %w", items, err)
+			return false
+		}
+		var n int
+		n, err = w.Write(data)
+		written += int64(n)
+		items++
+		return err == nil
+	})
+	return written, err
+}
+
+// SinkOptions configures WriteBuffered.
+type SinkOptions struct {
+	BufferSize int // size of the write buffer in bytes, defaults to 4096
+	FlushEvery int // flush after this many items; zero flushes only when the buffer is full and at the end
+}
+
+// WriteBuffered encodes every value of the sequence into a buffered writer on top of w,
+// flushing according to opts and once more after the last item.
+// It returns the number of bytes handed to w and stops at the first error.
+func WriteBuffered[T any](source Seq[T], w io.Writer, encode func(item T) ([]byte, error), opts SinkOptions) (int64, error) {
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = 4096
+	}
+	counter := &countingWriter{w: w}
+	buffered := bufio.NewWriterSize(counter, opts.BufferSize)
+
+	var err error
+	items := 0
+	source(func(item T) bool {
+		var data []byte
+		data, err = encode(item)
+		if err != nil {
+			err = fmt.Errorf("writeBuffered: encode item %d: %w", items, err)
+			return false
+		}
+		if _, err = buffered.Write(data); err != nil {
+			return false
+		}
+		items++
+		if opts.FlushEvery > 0 && items%opts.FlushEvery == 0 {
+			err = buffered.Flush()
+		}
+		return err == nil
+	})
+	if err != nil {
+		return counter.written, err
+	}
+	err = buffered.Flush()
+	return counter.written, err
+}
+
+// ToFileLines writes every string of the sequence as a line to the file at path,
+// creating or truncating it.
+func ToFileLines(source Seq[string], path string) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("toFileLines: %w", err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("toFileLines: %w", closeErr)
+		}
+	}()
+
+	_, err = WriteBuffered(source, file, func(line string) ([]byte, error) {
+		return []byte(line + "\n"), nil
+	}, SinkOptions{})
+	if err != nil {
+		return fmt.Errorf("toFileLines: %w", err)
+	}
+	return nil
+}
+
+// countingWriter counts the bytes successfully written to the underlying writer.
+type countingWriter struct {
+	w       io.Writer
+	written int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.written += int64(n)
+	return n, err
+}
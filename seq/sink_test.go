@@ -0,0 +1,107 @@
+package seq
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingWriter remembers the size of every Write call.
+type recordingWriter struct {
+	bytes.Buffer
+	writes []int
+}
+
+func (r *recordingWriter) Write(p []byte) (int, error) {
+	r.writes = append(r.writes, len(p))
+	return r.Buffer.Write(p)
+}
+
+func encodeInt(item int) ([]byte, error) {
+	return []byte(strconv.Itoa(item) + ","), nil
+}
+
+func TestWriteTo(t *testing.T) {
+	t.Run("writes every item", func(t *testing.T) {
+		var buf bytes.Buffer
+		n, err := WriteTo(From([]int{1, 22, 333}), &buf, encodeInt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), n)
+		assert.Equal(t, "1,22,333,", buf.String())
+	})
+
+	t.Run("encode error", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := WriteTo(From([]int{1, 2}), &buf, func(item int) ([]byte, error) {
+			if item == 2 {
+				return nil, errors.New("bad")
+			}
+			return encodeInt(item)
+		})
+
+		assert.EqualError(t, err, "writeTo: encode item 1: bad")
+		assert.Equal(t, "1,", buf.String())
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		_, err := WriteTo(From([]int{1}), failingWriter{}, encodeInt)
+		assert.EqualError(t, err, "closed")
+	})
+}
+
+func TestWriteBuffered(t *testing.T) {
+	t.Run("buffers until the end", func(t *testing.T) {
+		w := &recordingWriter{}
+		n, err := WriteBuffered(From([]int{1, 2, 3}), w, encodeInt, SinkOptions{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), n)
+		assert.Equal(t, "1,2,3,", w.String())
+		assert.Equal(t, []int{6}, w.writes)
+	})
+
+	t.Run("flushes every n items", func(t *testing.T) {
+		w := &recordingWriter{}
+		_, err := WriteBuffered(From([]int{1, 2, 3, 4, 5}), w, encodeInt, SinkOptions{FlushEvery: 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4, 4, 2}, w.writes)
+	})
+
+	t.Run("small buffer flushes when full", func(t *testing.T) {
+		w := &recordingWriter{}
+		_, err := WriteBuffered(From([]int{10, 20, 30}), w, encodeInt, SinkOptions{BufferSize: 4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "10,20,30,", w.String())
+		assert.Greater(t, len(w.writes), 1)
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		_, err := WriteBuffered(From([]int{1}), failingWriter{}, encodeInt, SinkOptions{})
+		assert.EqualError(t, err, "closed")
+	})
+}
+
+func TestToFileLines(t *testing.T) {
+	t.Run("writes lines to a file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "out.txt")
+
+		assert.NoError(t, ToFileLines(From([]string{"a", "b"}), path))
+
+		content, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "a\nb\n", string(content))
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		err := ToFileLines(From([]string{"a"}), filepath.Join(t.TempDir(), "missing", "out.txt"))
+		assert.Error(t, err)
+	})
+}
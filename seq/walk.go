@@ -0,0 +1,78 @@
+package seq
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// WalkEntry is a file or directory visited by WalkDir.
+type WalkEntry struct {
+	fs.DirEntry
+	Path string // slash-separated path relative to the walked file system
+}
+
+// Ext returns the file name extension of the entry, including the dot.
+func (e WalkEntry) Ext() string {
+	return path.Ext(e.Path)
+}
+
+// Depth returns the number of path elements below the file system root.
+func (e WalkEntry) Depth() int {
+	if e.Path == "." {
+		return 0
+	}
+	return strings.Count(e.Path, "/") + 1
+}
+
+// WalkDir lazily walks the tree rooted at root in lexical order, yielding every entry including root.
+// Entries that cannot be read are skipped; the returned function reports those errors
+// once the sequence has been consumed.
+func WalkDir(fsys fs.FS, root string) (Seq[WalkEntry], func() error) {
+	var errs []error
+	sequence := func(yield func(WalkEntry) bool) {
+		errs = nil
+		_ = fs.WalkDir(fsys, root, func(p string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				errs = append(errs, fmt.Errorf("walkDir: %w", err))
+				return nil
+			}
+			if !yield(WalkEntry{DirEntry: entry, Path: p}) {
+				return fs.SkipAll
+			}
+			return nil
+		})
+	}
+	return sequence, func() error { return errors.Join(errs...) }
+}
+
+// IsFile reports whether the entry is a regular file.
+func IsFile(entry WalkEntry) bool {
+	return entry.Type().IsRegular()
+}
+
+// GlobName returns a predicate matching entries whose base name matches the path.Match pattern.
+// Example:
+//   - match, err := GlobName("*.log"); then Filter(entries, match) keeps every log file at any depth.
+func GlobName(pattern string) (func(WalkEntry) bool, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("globName: %w", err)
+	}
+	return func(entry WalkEntry) bool {
+		matched, _ := path.Match(pattern, entry.Name())
+		return matched
+	}, nil
+}
+
+// GlobPath returns a predicate matching entries whose full slash-separated path matches the path.Match pattern.
+func GlobPath(pattern string) (func(WalkEntry) bool, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("globPath: %w", err)
+	}
+	return func(entry WalkEntry) bool {
+		matched, _ := path.Match(pattern, entry.Path)
+		return matched
+	}, nil
+}
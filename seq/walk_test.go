@@ -0,0 +1,78 @@
+package seq
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"a.log":          {Data: []byte("a")},
+		"docs/readme.md": {Data: []byte("r")},
+		"logs/b.log":     {Data: []byte("b")},
+		"logs/old/c.log": {Data: []byte("c")},
+	}
+}
+
+func entryPaths(entries []WalkEntry) []string {
+	paths := []string{}
+	for _, entry := range entries {
+		paths = append(paths, entry.Path)
+	}
+	return paths
+}
+
+func TestWalkDir(t *testing.T) {
+	t.Run("walks lexically", func(t *testing.T) {
+		entries, errs := WalkDir(testFS(), ".")
+
+		assert.Equal(t, []string{".", "a.log", "docs", "docs/readme.md", "logs", "logs/b.log", "logs/old", "logs/old/c.log"},
+			entryPaths(entries.Collect()))
+		assert.NoError(t, errs())
+	})
+
+	t.Run("filters files by glob", func(t *testing.T) {
+		entries, _ := WalkDir(testFS(), ".")
+		logs, err := GlobName("*.log")
+		assert.NoError(t, err)
+
+		files := Filter(Filter(entries, IsFile), logs).Collect()
+		assert.Equal(t, []string{"a.log", "logs/b.log", "logs/old/c.log"}, entryPaths(files))
+		assert.Equal(t, ".log", files[0].Ext())
+		assert.Equal(t, 3, files[2].Depth())
+	})
+
+	t.Run("glob on full path", func(t *testing.T) {
+		entries, _ := WalkDir(testFS(), "logs")
+		direct, err := GlobPath("logs/*.log")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"logs/b.log"}, entryPaths(Filter(entries, direct).Collect()))
+	})
+
+	t.Run("stops walking early", func(t *testing.T) {
+		entries, _ := WalkDir(testFS(), ".")
+		visited := 0
+		entries(func(entry WalkEntry) bool {
+			visited++
+			return visited < 2
+		})
+		assert.Equal(t, 2, visited)
+	})
+
+	t.Run("missing root is reported", func(t *testing.T) {
+		entries, errs := WalkDir(testFS(), "missing")
+
+		assert.Empty(t, entries.Collect())
+		assert.ErrorContains(t, errs(), "walkDir:")
+	})
+
+	t.Run("bad pattern", func(t *testing.T) {
+		_, err := GlobName("[")
+		assert.Error(t, err)
+		_, err = GlobPath("[")
+		assert.Error(t, err)
+	})
+}
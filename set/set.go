@@ -0,0 +1,122 @@
+// Package set provides Set, a generic hash set with algebraic operations.
+package set
+
+// Set is an unordered collection of distinct comparable values.
+// Add and Remove modify the set in place; Union, Intersect, Difference and
+// SymmetricDifference return new sets and leave their operands unchanged.
+// The zero value is a nil set that can be read but not added to; use New.
+type Set[T comparable] map[T]struct{}
+
+// New creates a set holding the given items.
+func New[T comparable](items ...T) Set[T] {
+	return FromSlice(items)
+}
+
+// FromSlice creates a set holding the elements of source, dropping duplicates.
+func FromSlice[T comparable](source []T) Set[T] {
+	s := make(Set[T], len(source))
+	s.Add(source...)
+	return s
+}
+
+// Add inserts the items into the set.
+func (s Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Remove deletes the items from the set. Items that are not present are ignored.
+func (s Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s, item)
+	}
+}
+
+// Contains reports whether item is in the set.
+func (s Set[T]) Contains(item T) bool {
+	_, ok := s[item]
+	return ok
+}
+
+// Len returns the number of elements in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone returns a copy of the set.
+func (s Set[T]) Clone() Set[T] {
+	clone := make(Set[T], len(s))
+	for item := range s {
+		clone[item] = struct{}{}
+	}
+	return clone
+}
+
+// ToSlice returns the elements of the set in unspecified order.
+func (s Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s))
+	for item := range s {
+		result = append(result, item)
+	}
+	return result
+}
+
+// Union returns a new set holding the elements of s and other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := s.Clone()
+	for item := range other {
+		result[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersect returns a new set holding the elements present in both s and other.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(large) < len(small) {
+		small, large = large, small
+	}
+	result := make(Set[T])
+	for item := range small {
+		if large.Contains(item) {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set holding the elements of s that are not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T])
+	for item := range s {
+		if !other.Contains(item) {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set holding the elements present in exactly one of s and other.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := s.Difference(other)
+	for item := range other {
+		if !s.Contains(item) {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Equal reports whether s and other hold the same elements.
+func (s Set[T]) Equal(other Set[T]) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for item := range s {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
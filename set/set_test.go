@@ -0,0 +1,54 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("construction drops duplicates", func(t *testing.T) {
+		s := FromSlice([]int{1, 2, 2, 3})
+		assert.Equal(t, 3, s.Len())
+		assert.ElementsMatch(t, []int{1, 2, 3}, s.ToSlice())
+		assert.Equal(t, 0, New[int]().Len())
+	})
+
+	t.Run("Add, Remove and Contains", func(t *testing.T) {
+		s := New("a")
+		s.Add("b", "c")
+		s.Remove("a", "missing")
+		assert.False(t, s.Contains("a"))
+		assert.True(t, s.Contains("b"))
+		assert.Equal(t, 2, s.Len())
+	})
+
+	t.Run("nil set can be read", func(t *testing.T) {
+		var s Set[int]
+		assert.False(t, s.Contains(1))
+		assert.Empty(t, s.ToSlice())
+		assert.True(t, s.Union(New(1)).Equal(New(1)))
+	})
+
+	t.Run("Clone is independent", func(t *testing.T) {
+		s := New(1)
+		clone := s.Clone()
+		clone.Add(2)
+		assert.Equal(t, 1, s.Len())
+	})
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(3, 4)
+
+	assert.True(t, New(1, 2, 3, 4).Equal(a.Union(b)))
+	assert.True(t, New(3).Equal(a.Intersect(b)))
+	assert.True(t, New(1, 2).Equal(a.Difference(b)))
+	assert.True(t, New(4).Equal(b.Difference(a)))
+	assert.True(t, New(1, 2, 4).Equal(a.SymmetricDifference(b)))
+
+	assert.True(t, New(1, 2, 3).Equal(a), "operands are not modified")
+	assert.True(t, New(3, 4).Equal(b), "operands are not modified")
+	assert.False(t, a.Equal(New(1, 2, 4)))
+}
@@ -0,0 +1,155 @@
+// Package stream provides channel-based stream operators.
+package stream
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// OverflowPolicy decides what a Buffer does when values arrive faster than they are consumed.
+type OverflowPolicy int
+
+const (
+	// Block stops reading from the input while the buffer is full, pushing back on the producer.
+	Block OverflowPolicy = iota
+	// DropOldest discards the oldest buffered value to make room for the new one.
+	DropOldest
+	// DropNewest discards the incoming value while the buffer is full.
+	DropNewest
+	// Sample forwards only the latest value received in each SampleInterval.
+	Sample
+)
+
+// BufferOptions configures a Buffer.
+type BufferOptions struct {
+	Size           int            // buffered values, defaults to 16
+	Policy         OverflowPolicy // defaults to Block
+	SampleInterval time.Duration  // sampling period for Sample, defaults to 10ms
+}
+
+// BufferStats is a snapshot of a Buffer's counters.
+type BufferStats struct {
+	Received  uint64 // values read from the input
+	Emitted   uint64 // values delivered to the output
+	Dropped   uint64 // values discarded by the overflow policy
+	HighWater int    // largest number of values buffered at once
+}
+
+// Buffer decouples a producer channel from a consumer with a bounded buffer and an overflow policy.
+type Buffer[T any] struct {
+	out       chan T
+	received  atomic.Uint64
+	emitted   atomic.Uint64
+	dropped   atomic.Uint64
+	highWater atomic.Int64
+}
+
+// NewBuffer starts buffering values from in. The output channel is closed after in is closed and
+// the buffered values are delivered, or as soon as ctx is cancelled.
+func NewBuffer[T any](ctx context.Context, in <-chan T, opts BufferOptions) *Buffer[T] {
+	if opts.Size <= 0 {
+		opts.Size = 16
+	}
+	if opts.SampleInterval <= 0 {
+		opts.SampleInterval = 10 * time.Millisecond
+	}
+	b := &Buffer[T]{out: make(chan T)}
+	go b.run(ctx, in, opts)
+	return b
+}
+
+// Out returns the buffered output channel.
+func (b *Buffer[T]) Out() <-chan T {
+	return b.out
+}
+
+// Stats returns the current counters.
+func (b *Buffer[T]) Stats() BufferStats {
+	return BufferStats{
+		Received:  b.received.Load(),
+		Emitted:   b.emitted.Load(),
+		Dropped:   b.dropped.Load(),
+		HighWater: int(b.highWater.Load()),
+	}
+}
+
+func (b *Buffer[T]) run(ctx context.Context, in <-chan T, opts BufferOptions) {
+	defer close(b.out)
+
+	var queue []T
+	var latest T
+	hasLatest := false
+
+	var tick <-chan time.Time
+	if opts.Policy == Sample {
+		ticker := time.NewTicker(opts.SampleInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	enqueue := func(value T) {
+		if len(queue) >= opts.Size {
+			if opts.Policy == DropNewest {
+				b.dropped.Add(1)
+				return
+			}
+			// DropOldest, and Sample when the consumer falls behind the sampling rate.
+			queue = queue[1:]
+			b.dropped.Add(1)
+		}
+		queue = append(queue, value)
+		if depth := int64(len(queue)); depth > b.highWater.Load() {
+			b.highWater.Store(depth)
+		}
+	}
+
+	input := in
+	for input != nil || len(queue) > 0 || hasLatest {
+		if input == nil && hasLatest {
+			enqueue(latest)
+			hasLatest = false
+		}
+
+		// Block stops reading while full, so the producer waits on its send.
+		reading := input
+		if opts.Policy == Block && len(queue) >= opts.Size {
+			reading = nil
+		}
+		var sending chan T
+		var next T
+		if len(queue) > 0 {
+			sending = b.out
+			next = queue[0]
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case value, ok := <-reading:
+			if !ok {
+				input = nil
+				continue
+			}
+			b.received.Add(1)
+			if opts.Policy == Sample {
+				if hasLatest {
+					b.dropped.Add(1)
+				}
+				latest, hasLatest = value, true
+				continue
+			}
+			enqueue(value)
+		case <-tick:
+			if hasLatest {
+				enqueue(latest)
+				hasLatest = false
+			}
+		case sending <- next:
+			var zero T
+			queue[0] = zero
+			queue = queue[1:]
+			b.emitted.Add(1)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func send(in chan<- int, values ...int) {
+	for _, v := range values {
+		in <- v
+	}
+}
+
+func drain[T any](out <-chan T) []T {
+	result := []T{}
+	for v := range out {
+		result = append(result, v)
+	}
+	return result
+}
+
+func TestBuffer(t *testing.T) {
+	t.Run("passes values through in order", func(t *testing.T) {
+		in := make(chan int)
+		buffer := NewBuffer(context.Background(), in, BufferOptions{})
+		go func() {
+			send(in, 1, 2, 3)
+			close(in)
+		}()
+		assert.Equal(t, []int{1, 2, 3}, drain(buffer.Out()))
+		stats := buffer.Stats()
+		assert.Equal(t, uint64(3), stats.Received)
+		assert.Equal(t, uint64(3), stats.Emitted)
+		assert.Equal(t, uint64(0), stats.Dropped)
+	})
+
+	t.Run("Block pushes back on the producer", func(t *testing.T) {
+		in := make(chan int)
+		buffer := NewBuffer(context.Background(), in, BufferOptions{Size: 2, Policy: Block})
+		send(in, 1, 2)
+
+		select {
+		case in <- 3:
+			t.Fatal("send should block while the buffer is full")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		assert.Equal(t, 1, <-buffer.Out())
+		send(in, 3)
+		close(in)
+		assert.Equal(t, []int{2, 3}, drain(buffer.Out()))
+		assert.Equal(t, 2, buffer.Stats().HighWater)
+	})
+
+	t.Run("DropOldest keeps the newest values", func(t *testing.T) {
+		in := make(chan int)
+		buffer := NewBuffer(context.Background(), in, BufferOptions{Size: 2, Policy: DropOldest})
+		send(in, 1, 2, 3, 4, 5)
+		close(in)
+		assert.Equal(t, []int{4, 5}, drain(buffer.Out()))
+		assert.Equal(t, BufferStats{Received: 5, Emitted: 2, Dropped: 3, HighWater: 2}, buffer.Stats())
+	})
+
+	t.Run("DropNewest keeps the oldest values", func(t *testing.T) {
+		in := make(chan int)
+		buffer := NewBuffer(context.Background(), in, BufferOptions{Size: 2, Policy: DropNewest})
+		send(in, 1, 2, 3, 4, 5)
+		close(in)
+		assert.Equal(t, []int{1, 2}, drain(buffer.Out()))
+		assert.Equal(t, BufferStats{Received: 5, Emitted: 2, Dropped: 3, HighWater: 2}, buffer.Stats())
+	})
+
+	t.Run("Sample forwards the latest value per interval", func(t *testing.T) {
+		in := make(chan int)
+		buffer := NewBuffer(context.Background(), in, BufferOptions{Policy: Sample, SampleInterval: time.Hour})
+		send(in, 1, 2, 3, 4, 5)
+		close(in)
+		assert.Equal(t, []int{5}, drain(buffer.Out()))
+		assert.Equal(t, uint64(4), buffer.Stats().Dropped)
+	})
+
+	t.Run("Sample emits on each tick", func(t *testing.T) {
+		in := make(chan int)
+		buffer := NewBuffer(context.Background(), in, BufferOptions{Policy: Sample, SampleInterval: time.Millisecond})
+		send(in, 1)
+		assert.Equal(t, 1, <-buffer.Out())
+		send(in, 2)
+		assert.Equal(t, 2, <-buffer.Out())
+		close(in)
+		assert.Equal(t, []int{}, drain(buffer.Out()))
+	})
+
+	t.Run("cancellation closes the output", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		buffer := NewBuffer(ctx, in, BufferOptions{Size: 4})
+		send(in, 1, 2)
+		cancel()
+		for range buffer.Out() {
+		}
+		select {
+		case in <- 3:
+			t.Fatal("buffer should stop reading after cancellation")
+		case <-time.After(20 * time.Millisecond):
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// errClosed is the cancellation cause of a pipeline that its consumer finished with.
+var errClosed = errors.New("stream: closed")
+
+// Stream is a channel-backed pipeline stage. Every stage of a pipeline shares one context:
+// cancelling it, or an error in any stage, stops the whole pipeline and is reported by Collect.
+// Collect releases the context when it returns; consumers reading Out directly call Close instead.
+type Stream[T any] struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	out    <-chan T
+}
+
+// FromChannel starts a pipeline reading from ch until it is closed or ctx is cancelled.
+// Example:
+//   - stream.Collect(stream.Map(stream.FromChannel(ctx, messages), decode))
+func FromChannel[T any](ctx context.Context, ch <-chan T) Stream[T] {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return Stream[T]{ctx: ctx, cancel: cancel, out: ch}
+}
+
+// Out returns the output channel of the stage. After it is closed, Err reports why.
+func (s Stream[T]) Out() <-chan T {
+	return s.out
+}
+
+// Err returns the error that stopped the pipeline: the first stage error, or the cause of the
+// context cancellation. It returns nil while the pipeline is running normally and after Close.
+func (s Stream[T]) Err() error {
+	if s.ctx.Err() == nil {
+		return nil
+	}
+	if cause := context.Cause(s.ctx); !errors.Is(cause, errClosed) {
+		return cause
+	}
+	return nil
+}
+
+// Close stops every stage of the pipeline and releases its context. It is safe to call more than once
+// and keeps the first stage error, if any, for Err.
+func (s Stream[T]) Close() {
+	s.cancel(errClosed)
+}
+
+// Map returns a stage applying transform to every value. The first error stops the pipeline.
+func Map[T1 any, T2 any](source Stream[T1], transform func(ctx context.Context, item T1) (T2, error)) Stream[T2] {
+	return pipe(source, func(item T1, emit func(T2) bool) error {
+		value, err := transform(source.ctx, item)
+		if err != nil {
+			return fmt.Errorf("stream: map: %w", err)
+		}
+		emit(value)
+		return nil
+	})
+}
+
+// Filter returns a stage keeping the values satisfying the predicate. The first error stops the pipeline.
+func Filter[T any](source Stream[T], predicate func(ctx context.Context, item T) (bool, error)) Stream[T] {
+	return pipe(source, func(item T, emit func(T) bool) error {
+		keep, err := predicate(source.ctx, item)
+		if err != nil {
+			return fmt.Errorf("stream: filter: %w", err)
+		}
+		if keep {
+			emit(item)
+		}
+		return nil
+	})
+}
+
+// Buffer returns a stage that decouples the upstream stages from the consumer with a NewBuffer.
+func (s Stream[T]) Buffer(opts BufferOptions) Stream[T] {
+	return Stream[T]{ctx: s.ctx, cancel: s.cancel, out: NewBuffer(s.ctx, s.out, opts).Out()}
+}
+
+// Collect consumes the stream and returns its values in order. It returns the values received so far
+// together with the error when a stage fails or the context is cancelled, and closes the pipeline.
+func (s Stream[T]) Collect() ([]T, error) {
+	defer s.Close()
+	result := []T{}
+	for {
+		select {
+		case <-s.ctx.Done():
+			return result, context.Cause(s.ctx)
+		case value, ok := <-s.out:
+			if !ok {
+				return result, s.Err()
+			}
+			result = append(result, value)
+		}
+	}
+}
+
+// pipe starts a stage goroutine running step for every value of source.
+// emit reports false when the pipeline was cancelled while sending.
+func pipe[T1 any, T2 any](source Stream[T1], step func(item T1, emit func(T2) bool) error) Stream[T2] {
+	out := make(chan T2)
+	ctx := source.ctx
+	emit := func(value T2) bool {
+		select {
+		case out <- value:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case item, ok := <-source.out:
+				if !ok {
+					return
+				}
+				if err := step(item, emit); err != nil {
+					source.cancel(err)
+					return
+				}
+			}
+		}
+	}()
+	return Stream[T2]{ctx: ctx, cancel: source.cancel, out: out}
+}
@@ -0,0 +1,117 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeline(t *testing.T) {
+	parse := func(_ context.Context, s string) (int, error) { return strconv.Atoi(s) }
+	isEven := func(_ context.Context, n int) (bool, error) { return n%2 == 0, nil }
+
+	t.Run("map, filter and buffer", func(t *testing.T) {
+		in := make(chan string)
+		go func() {
+			defer close(in)
+			for _, s := range []string{"1", "2", "3", "4"} {
+				in <- s
+			}
+		}()
+
+		values, err := Filter(Map(FromChannel(context.Background(), in), parse), isEven).
+			Buffer(BufferOptions{Size: 2}).
+			Collect()
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4}, values)
+	})
+
+	t.Run("stage errors propagate downstream", func(t *testing.T) {
+		in := make(chan string, 3)
+		in <- "1"
+		in <- "x"
+		in <- "3"
+		close(in)
+
+		values, err := Filter(Map(FromChannel(context.Background(), in), parse), isEven).Collect()
+		assert.ErrorIs(t, err, strconv.ErrSyntax)
+		assert.Contains(t, err.Error(), "stream: map")
+		assert.Empty(t, values)
+	})
+
+	t.Run("filter errors", func(t *testing.T) {
+		in := make(chan int, 1)
+		in <- 1
+		close(in)
+		boom := errors.New("boom")
+
+		s := Filter(FromChannel(context.Background(), in), func(context.Context, int) (bool, error) { return false, boom })
+		_, err := s.Collect()
+		assert.ErrorIs(t, err, boom)
+	})
+
+	t.Run("context cancellation stops an endless source", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		go func() {
+			for i := 0; ; i++ {
+				select {
+				case in <- i:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		s := Map(FromChannel(ctx, in), func(_ context.Context, n int) (int, error) { return n * 2, nil })
+		time.AfterFunc(20*time.Millisecond, cancel)
+		values, err := s.Collect()
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.NotEmpty(t, values)
+	})
+
+	t.Run("Out and Err", func(t *testing.T) {
+		in := make(chan string, 1)
+		in <- "7"
+		close(in)
+
+		s := Map(FromChannel(context.Background(), in), parse)
+		assert.Equal(t, []int{7}, drain(s.Out()))
+		assert.NoError(t, s.Err())
+	})
+
+	t.Run("Collect releases the pipeline context", func(t *testing.T) {
+		in := make(chan int, 2)
+		in <- 1
+		in <- 2
+		close(in)
+
+		s := Map(FromChannel(context.Background(), in), func(_ context.Context, n int) (int, error) { return n, nil })
+		values, err := s.Collect()
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, values)
+		assert.Error(t, s.ctx.Err())
+		assert.NoError(t, s.Err())
+	})
+
+	t.Run("Close stops the pipeline and keeps stage errors", func(t *testing.T) {
+		in := make(chan int)
+		s := Map(FromChannel(context.Background(), in), func(_ context.Context, n int) (int, error) { return n, nil })
+		s.Close()
+		assert.Empty(t, drain(s.Out()))
+		assert.NoError(t, s.Err())
+
+		failing := make(chan int, 1)
+		failing <- 1
+		close(failing)
+		boom := errors.New("boom")
+		f := Map(FromChannel(context.Background(), failing), func(context.Context, int) (int, error) { return 0, boom })
+		drain(f.Out())
+		f.Close()
+		assert.ErrorIs(t, f.Err(), boom)
+	})
+}
@@ -0,0 +1,133 @@
+// Package transducer provides reusable transformations that are defined once and applied in a
+// single pass to slices, lazy sequences or channels, without intermediate collections.
+package transducer
+
+import (
+	"context"
+
+	seq "github.com/lumiluminousai/golang-fp-utility/seq"
+)
+
+// Transducer turns a downstream step for B values into a step for A values. A step returns false
+// to stop the input early, e.g. once Take has seen enough values. A Transducer is called once per
+// application, so stateful steps such as Take start fresh every time.
+type Transducer[A any, B any] func(next func(B) bool) func(A) bool
+
+// Map transforms every value.
+func Map[A any, B any](transform func(A) B) Transducer[A, B] {
+	return func(next func(B) bool) func(A) bool {
+		return func(item A) bool {
+			return next(transform(item))
+		}
+	}
+}
+
+// Filter keeps the values satisfying the predicate.
+func Filter[A any](predicate func(A) bool) Transducer[A, A] {
+	return func(next func(A) bool) func(A) bool {
+		return func(item A) bool {
+			if !predicate(item) {
+				return true
+			}
+			return next(item)
+		}
+	}
+}
+
+// Take keeps the first n values and then stops the input.
+func Take[A any](n int) Transducer[A, A] {
+	return func(next func(A) bool) func(A) bool {
+		taken := 0
+		return func(item A) bool {
+			if taken >= n {
+				return false
+			}
+			taken++
+			return next(item) && taken < n
+		}
+	}
+}
+
+// TakeWhile keeps values while the predicate holds and stops the input at the first that fails.
+func TakeWhile[A any](predicate func(A) bool) Transducer[A, A] {
+	return func(next func(A) bool) func(A) bool {
+		return func(item A) bool {
+			return predicate(item) && next(item)
+		}
+	}
+}
+
+// Compose runs first and then second, fusing both into one step.
+// Example:
+//   - Compose(Filter(isActive), Map(toDTO)) filters and maps in one pass.
+func Compose[A any, B any, C any](first Transducer[A, B], second Transducer[B, C]) Transducer[A, C] {
+	return func(next func(C) bool) func(A) bool {
+		return first(second(next))
+	}
+}
+
+// Transduce applies t to source and folds the results with reduceFunc, without building
+// any intermediate slice.
+func Transduce[A any, B any, R any](source []A, t Transducer[A, B], reduceFunc func(acc R, item B) R, initialValue R) R {
+	acc := initialValue
+	step := t(func(item B) bool {
+		acc = reduceFunc(acc, item)
+		return true
+	})
+	for _, item := range source {
+		if !step(item) {
+			break
+		}
+	}
+	return acc
+}
+
+// Slice applies t to source and returns the results as a new slice.
+func Slice[A any, B any](source []A, t Transducer[A, B]) []B {
+	return Transduce(source, t, func(acc []B, item B) []B { return append(acc, item) }, []B{})
+}
+
+// Seq applies t lazily to a sequence.
+func Seq[A any, B any](source seq.Seq[A], t Transducer[A, B]) seq.Seq[B] {
+	return func(yield func(B) bool) {
+		stopped := false
+		step := t(func(item B) bool {
+			if !yield(item) {
+				stopped = true
+				return false
+			}
+			return true
+		})
+		source(func(item A) bool {
+			return step(item) && !stopped
+		})
+	}
+}
+
+// Chan applies t to the values received from in. The output channel is closed when in is closed,
+// when t stops the input, or when ctx is cancelled.
+func Chan[A any, B any](ctx context.Context, in <-chan A, t Transducer[A, B]) <-chan B {
+	out := make(chan B)
+	go func() {
+		defer close(out)
+		step := t(func(item B) bool {
+			select {
+			case out <- item:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case item, ok := <-in:
+				if !ok || !step(item) {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,69 @@
+package transducer
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	seq "github.com/lumiluminousai/golang-fp-utility/seq"
+)
+
+var evenSquaresAsText = Compose(Compose(Filter(func(n int) bool { return n%2 == 0 }), Map(func(n int) int { return n * n })), Map(strconv.Itoa))
+
+func TestSlice(t *testing.T) {
+	assert.Equal(t, []string{"4", "16", "36"}, Slice([]int{1, 2, 3, 4, 5, 6}, evenSquaresAsText))
+	assert.Equal(t, []string{}, Slice([]int{}, evenSquaresAsText))
+
+	t.Run("Take stops early and restarts per application", func(t *testing.T) {
+		firstTwo := Compose(evenSquaresAsText, Take[string](2))
+		visited := 0
+		counted := Compose(Map(func(n int) int { visited++; return n }), firstTwo)
+
+		assert.Equal(t, []string{"4", "16"}, Slice([]int{1, 2, 3, 4, 5, 6}, counted))
+		assert.Equal(t, 4, visited)
+		assert.Equal(t, []string{"4", "16"}, Slice([]int{1, 2, 3, 4, 5, 6}, counted))
+		assert.Equal(t, []int{}, Slice([]int{1, 2}, Take[int](0)))
+	})
+
+	t.Run("TakeWhile", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, Slice([]int{1, 2, 5, 1}, TakeWhile(func(n int) bool { return n < 3 })))
+	})
+}
+
+func TestTransduce(t *testing.T) {
+	sum := Transduce([]int{1, 2, 3, 4}, Compose(Filter(func(n int) bool { return n > 1 }), Map(func(n int) int { return n * 10 })), func(acc, n int) int { return acc + n }, 0)
+	assert.Equal(t, 90, sum)
+}
+
+func TestSeq(t *testing.T) {
+	naturals := seq.Iterate(1, func(n int) int { return n + 1 })
+	result := Seq(naturals, Compose(evenSquaresAsText, Take[string](3))).Collect()
+	assert.Equal(t, []string{"4", "16", "36"}, result)
+
+	assert.Equal(t, []string{"4"}, Seq(seq.Of(1, 2, 3, 4), evenSquaresAsText).Take(1).Collect())
+}
+
+func TestChan(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 1; i <= 6; i++ {
+			in <- i
+		}
+	}()
+
+	result := []string{}
+	for value := range Chan(context.Background(), in, evenSquaresAsText) {
+		result = append(result, value)
+	}
+	assert.Equal(t, []string{"4", "16", "36"}, result)
+
+	t.Run("cancelled context closes the output", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, ok := <-Chan(ctx, make(chan int), Map(strconv.Itoa))
+		assert.False(t, ok)
+	})
+}
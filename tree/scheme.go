@@ -0,0 +1,43 @@
+package tree
+
+// Cata folds any recursive structure bottom-up (a catamorphism). children exposes the
+// substructures of a node, and fold combines a node with the results of its substructures,
+// so expressions, ASTs or decoded JSON can be processed without hand-written recursion.
+// Example:
+//   - Cata(expr, Expr.Operands, func(e Expr, operands []int) int { return e.Apply(operands) }) evaluates an expression.
+func Cata[S any, R any](root S, children func(node S) []S, fold func(node S, results []R) R) R {
+	subs := children(root)
+	results := make([]R, len(subs))
+	for i, sub := range subs {
+		results[i] = Cata(sub, children, fold)
+	}
+	return fold(root, results)
+}
+
+// Ana unfolds a seed into a tree (an anamorphism). expand returns the value for a seed and the
+// seeds of its children; unfolding stops at seeds that expand to no children, so expand must
+// eventually return none on every branch.
+// Example:
+//   - Ana(8, func(n int) (int, []int) { if n <= 1 { return n, nil }; return n, []int{n / 2, n / 2} })
+func Ana[S any, T any](seed S, expand func(seed S) (T, []S)) Node[T] {
+	value, seeds := expand(seed)
+	node := Node[T]{Value: value}
+	if len(seeds) > 0 {
+		node.Children = make([]Node[T], len(seeds))
+		for i, s := range seeds {
+			node.Children[i] = Ana(s, expand)
+		}
+	}
+	return node
+}
+
+// Hylo unfolds a seed with expand and folds the result with fold (a hylomorphism) without
+// building the intermediate tree.
+func Hylo[S any, T any, R any](seed S, expand func(seed S) (T, []S), fold func(value T, results []R) R) R {
+	value, seeds := expand(seed)
+	results := make([]R, len(seeds))
+	for i, s := range seeds {
+		results[i] = Hylo(s, expand, fold)
+	}
+	return fold(value, results)
+}
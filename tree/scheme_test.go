@@ -0,0 +1,97 @@
+package tree
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// expr is a small user-defined recursive type used to exercise Cata.
+type expr struct {
+	op       string
+	value    int
+	operands []expr
+}
+
+func operandsOf(e expr) []expr { return e.operands }
+
+func TestCata(t *testing.T) {
+	t.Run("evaluate expression", func(t *testing.T) {
+		// (1 + 2) * (3 + 4)
+		e := expr{op: "*", operands: []expr{
+			{op: "+", operands: []expr{{value: 1}, {value: 2}}},
+			{op: "+", operands: []expr{{value: 3}, {value: 4}}},
+		}}
+		result := Cata(e, operandsOf, func(node expr, results []int) int {
+			switch node.op {
+			case "+":
+				return results[0] + results[1]
+			case "*":
+				return results[0] * results[1]
+			default:
+				return node.value
+			}
+		})
+		assert.Equal(t, 21, result)
+	})
+
+	t.Run("count leaves in decoded JSON", func(t *testing.T) {
+		var doc any
+		assert.NoError(t, json.Unmarshal([]byte(`{"a":[1,2,{"b":3}],"c":"x"}`), &doc))
+		children := func(v any) []any {
+			switch v := v.(type) {
+			case []any:
+				return v
+			case map[string]any:
+				keys := make([]string, 0, len(v))
+				for k := range v {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				values := make([]any, len(keys))
+				for i, k := range keys {
+					values[i] = v[k]
+				}
+				return values
+			}
+			return nil
+		}
+		leaves := Cata(doc, children, func(node any, results []int) int {
+			if len(results) == 0 {
+				return 1
+			}
+			total := 0
+			for _, r := range results {
+				total += r
+			}
+			return total
+		})
+		assert.Equal(t, 4, leaves)
+	})
+}
+
+func halve(n int) (int, []int) {
+	if n <= 1 {
+		return n, nil
+	}
+	return n, []int{n / 2, n - n/2}
+}
+
+func TestAna(t *testing.T) {
+	result := Ana(3, halve)
+	assert.Equal(t, New(3, New(1), New(2, New(1), New(1))), result)
+	assert.Equal(t, []int{1, 1, 1}, FlattenPreOrder(New(1, Ana(1, halve), Ana(1, halve))))
+}
+
+func TestHylo(t *testing.T) {
+	sumLeaves := func(value int, results []int) int {
+		if len(results) == 0 {
+			return value
+		}
+		return results[0] + results[1]
+	}
+	assert.Equal(t, 10, Hylo(10, halve, sumLeaves))
+	assert.Equal(t, FoldTree(Ana(10, halve), sumLeaves), Hylo(10, halve, sumLeaves))
+}
@@ -0,0 +1,83 @@
+// Package tree provides a generic rose tree and combinators for folding, mapping and traversing it.
+package tree
+
+// Node is a value with an ordered list of child nodes.
+type Node[T any] struct {
+	Value    T
+	Children []Node[T]
+}
+
+// New creates a node with the given children.
+func New[T any](value T, children ...Node[T]) Node[T] {
+	return Node[T]{Value: value, Children: children}
+}
+
+// FoldTree reduces a tree bottom-up: fn receives a node's value and the results already
+// computed for its children.
+// Example:
+//   - FoldTree(root, func(_ T, children []int) int { return 1 + Sum(children) }) counts the nodes.
+func FoldTree[T any, R any](node Node[T], fn func(value T, children []R) R) R {
+	results := make([]R, len(node.Children))
+	for i, child := range node.Children {
+		results[i] = FoldTree(child, fn)
+	}
+	return fn(node.Value, results)
+}
+
+// MapTree returns a tree of the same shape with every value transformed.
+func MapTree[T1 any, T2 any](node Node[T1], transform func(value T1) T2) Node[T2] {
+	mapped := Node[T2]{Value: transform(node.Value)}
+	if node.Children != nil {
+		mapped.Children = make([]Node[T2], len(node.Children))
+		for i, child := range node.Children {
+			mapped.Children[i] = MapTree(child, transform)
+		}
+	}
+	return mapped
+}
+
+// FilterTree removes every node that does not satisfy the predicate, together with its subtree.
+// It reports false when the root itself is removed.
+func FilterTree[T any](node Node[T], predicate func(value T) bool) (Node[T], bool) {
+	if !predicate(node.Value) {
+		return Node[T]{}, false
+	}
+	kept := Node[T]{Value: node.Value}
+	for _, child := range node.Children {
+		if filtered, ok := FilterTree(child, predicate); ok {
+			kept.Children = append(kept.Children, filtered)
+		}
+	}
+	return kept, true
+}
+
+// FlattenPreOrder lists the values with each node before its children.
+func FlattenPreOrder[T any](node Node[T]) []T {
+	result := []T{node.Value}
+	for _, child := range node.Children {
+		result = append(result, FlattenPreOrder(child)...)
+	}
+	return result
+}
+
+// FlattenPostOrder lists the values with each node after its children.
+func FlattenPostOrder[T any](node Node[T]) []T {
+	result := []T{}
+	for _, child := range node.Children {
+		result = append(result, FlattenPostOrder(child)...)
+	}
+	return append(result, node.Value)
+}
+
+// FlattenBreadthFirst lists the values level by level.
+func FlattenBreadthFirst[T any](node Node[T]) []T {
+	result := []T{}
+	queue := []Node[T]{node}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		result = append(result, current.Value)
+		queue = append(queue, current.Children...)
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package tree
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sample builds a(b(e,f),c,d(g)).
+func sample() Node[string] {
+	return New("a",
+		New("b", New("e"), New("f")),
+		New("c"),
+		New("d", New("g")),
+	)
+}
+
+func TestFoldTree(t *testing.T) {
+	t.Run("count nodes", func(t *testing.T) {
+		count := FoldTree(sample(), func(_ string, children []int) int {
+			total := 1
+			for _, c := range children {
+				total += c
+			}
+			return total
+		})
+		assert.Equal(t, 7, count)
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		depth := FoldTree(sample(), func(_ string, children []int) int {
+			deepest := 0
+			for _, c := range children {
+				if c > deepest {
+					deepest = c
+				}
+			}
+			return deepest + 1
+		})
+		assert.Equal(t, 3, depth)
+	})
+
+	t.Run("render", func(t *testing.T) {
+		rendered := FoldTree(sample(), func(value string, children []string) string {
+			if len(children) == 0 {
+				return value
+			}
+			return value + "(" + strings.Join(children, ",") + ")"
+		})
+		assert.Equal(t, "a(b(e,f),c,d(g))", rendered)
+	})
+}
+
+func TestMapTree(t *testing.T) {
+	mapped := MapTree(sample(), strings.ToUpper)
+	assert.Equal(t, []string{"A", "B", "E", "F", "C", "D", "G"}, FlattenPreOrder(mapped))
+
+	lengths := MapTree(New("ab", New("c")), func(s string) int { return len(s) })
+	assert.Equal(t, New(2, New(1)), lengths)
+}
+
+func TestFilterTree(t *testing.T) {
+	t.Run("prunes subtrees", func(t *testing.T) {
+		filtered, ok := FilterTree(sample(), func(v string) bool { return v != "b" })
+		assert.True(t, ok)
+		assert.Equal(t, []string{"a", "c", "d", "g"}, FlattenPreOrder(filtered))
+	})
+
+	t.Run("root removed", func(t *testing.T) {
+		_, ok := FilterTree(sample(), func(v string) bool { return v != "a" })
+		assert.False(t, ok)
+	})
+
+	t.Run("source is unchanged", func(t *testing.T) {
+		source := sample()
+		_, _ = FilterTree(source, func(v string) bool { return v != "e" })
+		assert.Equal(t, sample(), source)
+	})
+}
+
+func TestFlatten(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "e", "f", "c", "d", "g"}, FlattenPreOrder(sample()))
+	assert.Equal(t, []string{"e", "f", "b", "c", "g", "d", "a"}, FlattenPostOrder(sample()))
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f", "g"}, FlattenBreadthFirst(sample()))
+	assert.Equal(t, []string{"x"}, FlattenBreadthFirst(New("x")))
+}
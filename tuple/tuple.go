@@ -0,0 +1,62 @@
+// Package tuple provides lightweight generic tuple types.
+package tuple
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair creates a Pair from two values.
+func NewPair[A any, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Values returns both elements of the pair.
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns a pair with the elements exchanged.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// Fst returns the first element of a pair. As a function it can be passed to Map directly.
+// Example:
+//   - collection.Map(pairs, tuple.Fst[string, int]) returns the first elements.
+func Fst[A any, B any](p Pair[A, B]) A {
+	return p.First
+}
+
+// Snd returns the second element of a pair.
+func Snd[A any, B any](p Pair[A, B]) B {
+	return p.Second
+}
+
+// MapFirst returns a pair with fn applied to the first element.
+func MapFirst[A any, B any, C any](p Pair[A, B], fn func(A) C) Pair[C, B] {
+	return Pair[C, B]{First: fn(p.First), Second: p.Second}
+}
+
+// MapSecond returns a pair with fn applied to the second element.
+func MapSecond[A any, B any, C any](p Pair[A, B], fn func(B) C) Pair[A, C] {
+	return Pair[A, C]{First: p.First, Second: fn(p.Second)}
+}
+
+// Triple holds three values of possibly different types.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple creates a Triple from three values.
+func NewTriple[A any, B any, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// Values returns all elements of the triple.
+func (t Triple[A, B, C]) Values() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
@@ -0,0 +1,39 @@
+package tuple
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPair(t *testing.T) {
+	pair := NewPair("a", 1)
+
+	assert.Equal(t, Pair[string, int]{First: "a", Second: 1}, pair)
+
+	first, second := pair.Values()
+	assert.Equal(t, "a", first)
+	assert.Equal(t, 1, second)
+}
+
+func TestPairHelpers(t *testing.T) {
+	pair := NewPair("a", 1)
+
+	assert.Equal(t, "a", Fst(pair))
+	assert.Equal(t, 1, Snd(pair))
+	assert.Equal(t, NewPair(1, "a"), pair.Swap())
+	assert.Equal(t, NewPair("A", 1), MapFirst(pair, strings.ToUpper))
+	assert.Equal(t, NewPair("a", "1"), MapSecond(pair, strconv.Itoa))
+	assert.Equal(t, NewPair("a", 1), pair, "helpers do not modify the pair")
+}
+
+func TestTriple(t *testing.T) {
+	triple := NewTriple("a", 1, true)
+
+	first, second, third := triple.Values()
+	assert.Equal(t, "a", first)
+	assert.Equal(t, 1, second)
+	assert.True(t, third)
+}
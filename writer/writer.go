@@ -0,0 +1,71 @@
+// Package writer provides Writer, a value paired with an accumulated log.
+package writer
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Monoid describes how logs of type W are combined: Combine must be associative and
+// Empty must leave any log unchanged when combined with it.
+type Monoid[W any] struct {
+	Empty   W
+	Combine func(a, b W) W
+}
+
+// Slice returns the Monoid that concatenates slices, e.g. audit trail entries.
+// Combining always allocates a new slice, so earlier logs are never modified.
+func Slice[T any]() Monoid[[]T] {
+	return Monoid[[]T]{
+		Empty: []T{},
+		Combine: func(a, b []T) []T {
+			result := make([]T, 0, len(a)+len(b))
+			return append(append(result, a...), b...)
+		},
+	}
+}
+
+// Sum returns the Monoid that adds numbers, e.g. a running cost metric.
+func Sum[T constraints.Integer | constraints.Float]() Monoid[T] {
+	return Monoid[T]{Combine: func(a, b T) T { return a + b }}
+}
+
+// Writer holds a value together with the log accumulated while producing it.
+// Writers are values; every operation returns a new Writer.
+type Writer[W any, A any] struct {
+	monoid Monoid[W]
+	value  A
+	log    W
+}
+
+// Of creates a Writer holding value with an empty log.
+func Of[W any, A any](monoid Monoid[W], value A) Writer[W, A] {
+	return Writer[W, A]{monoid: monoid, value: value, log: monoid.Empty}
+}
+
+// Write creates a Writer holding value with entry as its log.
+// Example:
+//   - Write(writer.Slice[string](), total, []string{"applied discount"})
+func Write[W any, A any](monoid Monoid[W], value A, entry W) Writer[W, A] {
+	return Writer[W, A]{monoid: monoid, value: value, log: entry}
+}
+
+// Tell creates a Writer that only records entry.
+func Tell[W any](monoid Monoid[W], entry W) Writer[W, struct{}] {
+	return Write(monoid, struct{}{}, entry)
+}
+
+// Run returns the value and the accumulated log.
+func (w Writer[W, A]) Run() (A, W) {
+	return w.value, w.log
+}
+
+// Map transforms the value and keeps the log.
+func Map[W any, A any, B any](source Writer[W, A], transform func(A) B) Writer[W, B] {
+	return Writer[W, B]{monoid: source.monoid, value: transform(source.value), log: source.log}
+}
+
+// FlatMap chains a Writer produced from the value of source, combining both logs in order.
+func FlatMap[W any, A any, B any](source Writer[W, A], next func(A) Writer[W, B]) Writer[W, B] {
+	result := next(source.value)
+	return Writer[W, B]{monoid: source.monoid, value: result.value, log: source.monoid.Combine(source.log, result.log)}
+}
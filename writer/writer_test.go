@@ -0,0 +1,55 @@
+package writer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	audit := Slice[string]()
+
+	t.Run("Of has an empty log", func(t *testing.T) {
+		value, log := Of(audit, 5).Run()
+		assert.Equal(t, 5, value)
+		assert.Equal(t, []string{}, log)
+	})
+
+	t.Run("FlatMap accumulates logs in order", func(t *testing.T) {
+		discount := func(total float64) Writer[[]string, float64] {
+			return Write(audit, total*0.9, []string{"applied 10% discount"})
+		}
+		shipping := func(total float64) Writer[[]string, float64] {
+			return Write(audit, total+5, []string{"added shipping"})
+		}
+
+		total, log := FlatMap(FlatMap(Write(audit, 100.0, []string{"start"}), discount), shipping).Run()
+		assert.Equal(t, 95.0, total)
+		assert.Equal(t, []string{"start", "applied 10% discount", "added shipping"}, log)
+	})
+
+	t.Run("Map and Tell", func(t *testing.T) {
+		w := FlatMap(Tell(audit, []string{"noted"}), func(struct{}) Writer[[]string, int] { return Of(audit, 1) })
+		value, log := Map(w, func(n int) string { return "n=1" }).Run()
+		assert.Equal(t, "n=1", value)
+		assert.Equal(t, []string{"noted"}, log)
+	})
+
+	t.Run("earlier logs are not modified", func(t *testing.T) {
+		base := Write(audit, 0, make([]string, 1, 10))
+		a := FlatMap(base, func(int) Writer[[]string, int] { return Write(audit, 1, []string{"a"}) })
+		b := FlatMap(base, func(int) Writer[[]string, int] { return Write(audit, 2, []string{"b"}) })
+		_, logA := a.Run()
+		_, logB := b.Run()
+		assert.Equal(t, []string{"", "a"}, logA)
+		assert.Equal(t, []string{"", "b"}, logB)
+	})
+
+	t.Run("Sum monoid", func(t *testing.T) {
+		cost := Sum[int]()
+		step := func(n int) Writer[int, int] { return Write(cost, n*2, 3) }
+		value, calls := FlatMap(FlatMap(Of(cost, 1), step), step).Run()
+		assert.Equal(t, 4, value)
+		assert.Equal(t, 6, calls)
+	})
+}